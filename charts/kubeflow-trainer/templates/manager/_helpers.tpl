@@ -55,3 +55,7 @@ Create the name of the manager deployment.
 {{- define "trainer.manager.service.name" -}}
 {{ include "trainer.manager.name" . }}
 {{- end -}}
+
+{{- define "trainer.manager.configMap.name" -}}
+{{ include "trainer.manager.name" . }}-config
+{{- end -}}
@@ -17,37 +17,52 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"errors"
 	"flag"
 	"net/http"
 	"os"
+	"time"
 
 	zaplog "go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlpkg "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
-	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/config"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
 	"github.com/kubeflow/trainer/v2/pkg/controller"
+	"github.com/kubeflow/trainer/v2/pkg/metrics"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 	runtimecore "github.com/kubeflow/trainer/v2/pkg/runtime/core"
+	fwkplugins "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins"
+	"github.com/kubeflow/trainer/v2/pkg/tracing"
 	"github.com/kubeflow/trainer/v2/pkg/util/cert"
 	webhooks "github.com/kubeflow/trainer/v2/pkg/webhooks"
 )
 
 const (
-	webhookConfigurationName = "validator.trainer.kubeflow.org"
+	validatingWebhookConfigurationName = "validator.trainer.kubeflow.org"
+	mutatingWebhookConfigurationName   = "mutator.trainer.kubeflow.org"
+	tracingShutdownTimeout             = 5 * time.Second
 )
 
 var (
@@ -60,35 +75,21 @@ func init() {
 	utilruntime.Must(trainer.AddToScheme(scheme))
 	utilruntime.Must(jobsetv1alpha2.AddToScheme(scheme))
 	utilruntime.Must(schedulerpluginsv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(volcanov1beta1.AddToScheme(scheme))
+	utilruntime.Must(configapi.AddToScheme(scheme))
 }
 
 func main() {
-	var metricsAddr string
-	var enableLeaderElection bool
-	var probeAddr string
-	var secureMetrics bool
+	var configFile string
 	var enableHTTP2 bool
-	var webhookServerPort int
-	var webhookServiceName string
-	var webhookSecretName string
 	var tlsOpts []func(*tls.Config)
 
-	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
-		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
-	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
-	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
-		"Enable leader election for controller manager. "+
-			"Enabling this will ensure there is only one active controller manager.")
-	flag.BoolVar(&secureMetrics, "metrics-secure", true,
-		"If set, the metrics endpoint is served securely via HTTPS. Use --metrics-secure=false to use HTTP instead.")
+	flag.StringVar(&configFile, "config", "",
+		"The controller will load its initial configuration from this file. "+
+			"Omit this flag to use the default configuration values.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
 
-	// Cert generation flags
-	flag.IntVar(&webhookServerPort, "webhook-server-port", 9443, "Endpoint port for the webhook server.")
-	flag.StringVar(&webhookServiceName, "webhook-service-name", "kubeflow-trainer-controller-manager", "Name of the Service used as part of the DNSName")
-	flag.StringVar(&webhookSecretName, "webhook-secret-name", "kubeflow-trainer-webhook-cert", "Name of the Secret to store CA  and server certs")
-
 	opts := zap.Options{
 		TimeEncoder: zapcore.RFC3339NanoTimeEncoder,
 		ZapOpts:     []zaplog.Option{zaplog.AddCaller()},
@@ -110,43 +111,66 @@ func main() {
 			c.NextProtos = []string{"http/1.1"}
 		})
 	}
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
-		Scheme: scheme,
-		Client: client.Options{
-			Cache: &client.CacheOptions{
-				Unstructured: true,
-			},
-		},
-		Metrics: metricsserver.Options{
-			BindAddress:   metricsAddr,
-			SecureServing: secureMetrics,
-			TLSOpts:       tlsOpts,
+	options, cfg, err := config.Load(scheme, configFile)
+	if err != nil {
+		setupLog.Error(err, "unable to load the configuration")
+		os.Exit(1)
+	}
+	options.Client = client.Options{
+		Cache: &client.CacheOptions{
+			Unstructured: true,
 		},
-		WebhookServer: webhook.NewServer(webhook.Options{
-			Port:    webhookServerPort,
-			TLSOpts: tlsOpts,
-		}),
-		HealthProbeBindAddress: probeAddr,
+	}
+	// Only the ControllerRevisions with the runtime snapshots are cached.
+	runtimeSnapshotRequirement, err := labels.NewRequirement(constants.LabelTrainJobName, selection.Exists, nil)
+	if err != nil {
+		setupLog.Error(err, "unable to build the runtime snapshot selector")
+		os.Exit(1)
+	}
+	options.Cache.ByObject = map[client.Object]cache.ByObject{
+		&appsv1.ControllerRevision{}: {Label: labels.NewSelector().Add(*runtimeSnapshotRequirement)},
+	}
+	options.Metrics.TLSOpts = tlsOpts
+	options.WebhookServer = webhook.NewServer(webhook.Options{
+		Host:    cfg.Webhook.Host,
+		Port:    int(ptr.Deref(cfg.Webhook.Port, configapi.DefaultWebhookPort)),
+		CertDir: cfg.Webhook.CertDir,
+		TLSOpts: tlsOpts,
 	})
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), options)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
+	metrics.Register()
+
 	certsReady := make(chan struct{})
-	if err = cert.ManageCerts(mgr, cert.Config{
-		WebhookSecretName:        webhookSecretName,
-		WebhookServiceName:       webhookServiceName,
-		WebhookConfigurationName: webhookConfigurationName,
-	}, certsReady); err != nil {
-		setupLog.Error(err, "unable to set up cert rotation")
-		os.Exit(1)
+	if ptr.Deref(cfg.CertManagement.Enable, true) {
+		if err = cert.ManageCerts(mgr, cert.Config{
+			WebhookSecretName:                  cfg.CertManagement.WebhookSecretName,
+			WebhookServiceName:                 cfg.CertManagement.WebhookServiceName,
+			ValidatingWebhookConfigurationName: validatingWebhookConfigurationName,
+			MutatingWebhookConfigurationName:   mutatingWebhookConfigurationName,
+		}, certsReady); err != nil {
+			setupLog.Error(err, "unable to set up cert rotation")
+			os.Exit(1)
+		}
+	} else {
+		close(certsReady)
 	}
 
 	ctx := ctrl.SetupSignalHandler()
 
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+
 	setupProbeEndpoints(mgr, certsReady)
-	runtimes, err := runtimecore.New(ctx, mgr.GetClient(), mgr.GetFieldIndexer())
+	plugins := fwkplugins.NewEnabledRegistry(cfg.Plugins.Enabled, cfg.Plugins.Disabled)
+	runtimes, err := runtimecore.New(ctx, mgr.GetClient(), mgr.GetFieldIndexer(), plugins)
 	if err != nil {
 		setupLog.Error(err, "Could not initialize runtimes")
 		os.Exit(1)
@@ -155,7 +179,14 @@ func main() {
 	go setupControllers(mgr, runtimes, certsReady)
 
 	setupLog.Info("Starting manager")
-	if err = mgr.Start(ctx); err != nil {
+	err = mgr.Start(ctx)
+	// The signal handler context is already cancelled, so the pending spans are flushed with a new one.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	if shutdownErr := shutdownTracing(shutdownCtx); shutdownErr != nil {
+		setupLog.Error(shutdownErr, "Could not shut down tracing")
+	}
+	cancel()
+	if err != nil {
 		setupLog.Error(err, "Could not run manager")
 		os.Exit(1)
 	}
@@ -0,0 +1,263 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ConfigurationKind is the Kind name for the Configuration.
+	ConfigurationKind string = "Configuration"
+)
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration is the Schema for the trainer-controller-manager configuration.
+type Configuration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// ControllerManager returns the configurations for controllers.
+	ControllerManager `json:",inline"`
+
+	// CertManagement is configuration for certificate management used by the webhook server.
+	CertManagement *CertManagement `json:"certManagement,omitempty"`
+
+	// Plugins configures which runtime framework plugins are enabled.
+	Plugins *Plugins `json:"plugins,omitempty"`
+
+	// Tracing configures the OpenTelemetry tracing of the TrainJob reconciliation.
+	Tracing *Tracing `json:"tracing,omitempty"`
+}
+
+// ControllerManager is the configuration of the trainer-controller-manager.
+type ControllerManager struct {
+	// Webhook contains the controllers webhook configuration.
+	// +optional
+	Webhook ControllerWebhook `json:"webhook,omitempty"`
+
+	// LeaderElection is the LeaderElection config to be used when configuring
+	// the manager.Manager leader election.
+	// +optional
+	LeaderElection *LeaderElection `json:"leaderElection,omitempty"`
+
+	// Metrics contains the controller metrics configuration.
+	// +optional
+	Metrics ControllerMetrics `json:"metrics,omitempty"`
+
+	// Health contains the controller health configuration.
+	// +optional
+	Health ControllerHealth `json:"health,omitempty"`
+
+	// Controller contains global configuration options for controllers
+	// registered within this manager.
+	// +optional
+	Controller *ControllerConfigurationSpec `json:"controller,omitempty"`
+}
+
+// ControllerWebhook defines the webhook server for the controller.
+type ControllerWebhook struct {
+	// Port is the port that the webhook server serves at.
+	// It is used to set webhook.Server.Port.
+	// Defaults to 9443.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// Host is the hostname that the webhook server binds to.
+	// It is used to set webhook.Server.Host.
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// CertDir is the directory that contains the server key and certificate.
+	// If not set, webhook server would look up the server key and certificate in
+	// {TempDir}/k8s-webhook-server/serving-certs.
+	// +optional
+	CertDir string `json:"certDir,omitempty"`
+}
+
+// LeaderElection configures the leader election of the manager.
+type LeaderElection struct {
+	// LeaderElect enables a leader election client to gain leadership
+	// before executing the main loop.
+	// Defaults to false.
+	// +optional
+	LeaderElect *bool `json:"leaderElect,omitempty"`
+
+	// LeaseDuration is the duration that non-leader candidates will wait
+	// after observing a leadership renewal until attempting to acquire
+	// leadership of a led but unrenewed leader slot.
+	// Defaults to 15s.
+	// +optional
+	LeaseDuration *metav1.Duration `json:"leaseDuration,omitempty"`
+
+	// RenewDeadline is the interval between attempts by the acting master to
+	// renew a leadership slot before it stops leading.
+	// Defaults to 10s.
+	// +optional
+	RenewDeadline *metav1.Duration `json:"renewDeadline,omitempty"`
+
+	// RetryPeriod is the duration the clients should wait between attempting
+	// acquisition and renewal of a leadership.
+	// Defaults to 2s.
+	// +optional
+	RetryPeriod *metav1.Duration `json:"retryPeriod,omitempty"`
+
+	// ResourceLock indicates the resource object type that will be used to lock
+	// during leader election cycles.
+	// Defaults to "leases".
+	// +optional
+	ResourceLock string `json:"resourceLock,omitempty"`
+
+	// ResourceName indicates the name of resource object that will be used to lock
+	// during leader election cycles.
+	// Defaults to "trainer.kubeflow.org".
+	// +optional
+	ResourceName string `json:"resourceName,omitempty"`
+
+	// ResourceNamespace indicates the namespace of resource object that will be used to lock
+	// during leader election cycles.
+	// Defaults to the namespace in which the manager is running.
+	// +optional
+	ResourceNamespace string `json:"resourceNamespace,omitempty"`
+}
+
+// ControllerMetrics defines the metrics configs.
+type ControllerMetrics struct {
+	// BindAddress is the TCP address that the controller should bind to
+	// for serving prometheus metrics.
+	// It can be set to "0" to disable the metrics serving.
+	// Defaults to "0".
+	// +optional
+	BindAddress string `json:"bindAddress,omitempty"`
+
+	// SecureServing enables serving metrics via HTTPS.
+	// Defaults to true.
+	// +optional
+	SecureServing *bool `json:"secureServing,omitempty"`
+}
+
+// ControllerHealth defines the health configs.
+type ControllerHealth struct {
+	// HealthProbeBindAddress is the TCP address that the controller should bind to
+	// for serving health probes.
+	// It can be set to "0" or "" to disable serving the health probe.
+	// Defaults to ":8081".
+	// +optional
+	HealthProbeBindAddress string `json:"healthProbeBindAddress,omitempty"`
+
+	// ReadinessEndpointName, defaults to "readyz".
+	// +optional
+	ReadinessEndpointName string `json:"readinessEndpointName,omitempty"`
+
+	// LivenessEndpointName, defaults to "healthz".
+	// +optional
+	LivenessEndpointName string `json:"livenessEndpointName,omitempty"`
+}
+
+// ControllerConfigurationSpec defines the global configuration for
+// controllers registered with the manager.
+type ControllerConfigurationSpec struct {
+	// GroupKindConcurrency is a map from a Kind to the number of concurrent reconciliation
+	// allowed for the controller reconciling that Kind.
+	//
+	// The key is expected to be consistent in form with GroupKind.String(),
+	// e.g. TrainJob in group trainer.kubeflow.org is "TrainJob.trainer.kubeflow.org".
+	// +optional
+	GroupKindConcurrency map[string]int `json:"groupKindConcurrency,omitempty"`
+
+	// CacheSyncTimeout refers to the time limit set to wait for syncing caches.
+	// Defaults to 2 minutes if not set.
+	// +optional
+	CacheSyncTimeout *metav1.Duration `json:"cacheSyncTimeout,omitempty"`
+}
+
+// CertManagement configures the internal certificate management for the webhook server.
+type CertManagement struct {
+	// Enable controls the use of internal cert management for the webhook.
+	// When disabled, the certificates must be provisioned by an external tool, e.g. cert-manager.
+	// Defaults to true.
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+
+	// WebhookServiceName is the name of the Service used as part of the DNSName.
+	// Defaults to kubeflow-trainer-controller-manager.
+	// +optional
+	WebhookServiceName string `json:"webhookServiceName,omitempty"`
+
+	// WebhookSecretName is the name of the Secret used to store CA and server certs.
+	// Defaults to kubeflow-trainer-webhook-cert.
+	// +optional
+	WebhookSecretName string `json:"webhookSecretName,omitempty"`
+}
+
+// Plugins configures the runtime framework plugins.
+// The effective set of plugins is the default plugins and the Enabled ones, excluding the Disabled ones.
+// The Volcano plugin depends on the Volcano CRDs, hence it is not enabled by default,
+// and the runtimes and TrainJobs with the Volcano podGroupPolicy are rejected until it is enabled.
+// The Coscheduling plugin is enabled by default, and it skips watching PodGroups when their CRDs are not installed.
+// The JobSet plugin can not be disabled.
+type Plugins struct {
+	// Enabled is the list of plugin names to be enabled in addition to the default ones.
+	// +optional
+	Enabled []string `json:"enabled,omitempty"`
+
+	// Disabled is the list of plugin names to be disabled.
+	// +optional
+	Disabled []string `json:"disabled,omitempty"`
+}
+
+// TracingExporter is the exporter of the OpenTelemetry spans.
+type TracingExporter string
+
+const (
+	// TracingExporterNone disables the tracing.
+	TracingExporterNone TracingExporter = "None"
+
+	// TracingExporterStdout writes the spans to the standard output.
+	TracingExporterStdout TracingExporter = "Stdout"
+
+	// TracingExporterOTLP sends the spans to the OTLP collector over gRPC.
+	TracingExporterOTLP TracingExporter = "OTLP"
+)
+
+// Tracing configures the OpenTelemetry tracing.
+type Tracing struct {
+	// Exporter is the exporter of the spans. It can be "None", "Stdout", or "OTLP".
+	// Defaults to "None".
+	// +optional
+	Exporter TracingExporter `json:"exporter,omitempty"`
+
+	// Endpoint is the host and port of the OTLP collector, e.g. "otel-collector:4317".
+	// It is required when the Exporter is "OTLP".
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Insecure disables the transport security for the connection to the OTLP collector.
+	// Defaults to false.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+
+	// SamplingRatePerMillion is the number of sampled traces per million root spans.
+	// Defaults to 1000000, which samples all traces.
+	// +optional
+	SamplingRatePerMillion *int32 `json:"samplingRatePerMillion,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Configuration{})
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	DefaultWebhookServiceName           = "kubeflow-trainer-controller-manager"
+	DefaultWebhookSecretName            = "kubeflow-trainer-webhook-cert"
+	DefaultWebhookPort            int32 = 9443
+	DefaultHealthProbeBindAddress       = ":8081"
+	DefaultMetricsBindAddress           = "0"
+	DefaultReadinessEndpoint            = "readyz"
+	DefaultLivenessEndpoint             = "healthz"
+	DefaultLeaderElectionID             = "trainer.kubeflow.org"
+	DefaultResourceLock                 = "leases"
+	DefaultLeaseDuration                = 15 * time.Second
+	DefaultRenewDeadline                = 10 * time.Second
+	DefaultRetryPeriod                  = 2 * time.Second
+	DefaultSamplingRatePerMillion int32 = 1000000
+)
+
+// SetDefaults_Configuration sets default values for the Configuration.
+//
+//nolint:revive // format required by generated code for defaulting
+func SetDefaults_Configuration(cfg *Configuration) {
+	if cfg.Webhook.Port == nil {
+		cfg.Webhook.Port = ptr.To(DefaultWebhookPort)
+	}
+	if len(cfg.Metrics.BindAddress) == 0 {
+		cfg.Metrics.BindAddress = DefaultMetricsBindAddress
+	}
+	if cfg.Metrics.SecureServing == nil {
+		cfg.Metrics.SecureServing = ptr.To(true)
+	}
+	if len(cfg.Health.HealthProbeBindAddress) == 0 {
+		cfg.Health.HealthProbeBindAddress = DefaultHealthProbeBindAddress
+	}
+	if len(cfg.Health.ReadinessEndpointName) == 0 {
+		cfg.Health.ReadinessEndpointName = DefaultReadinessEndpoint
+	}
+	if len(cfg.Health.LivenessEndpointName) == 0 {
+		cfg.Health.LivenessEndpointName = DefaultLivenessEndpoint
+	}
+
+	if cfg.LeaderElection == nil {
+		cfg.LeaderElection = &LeaderElection{}
+	}
+	if cfg.LeaderElection.LeaderElect == nil {
+		cfg.LeaderElection.LeaderElect = ptr.To(false)
+	}
+	if cfg.LeaderElection.LeaseDuration == nil {
+		cfg.LeaderElection.LeaseDuration = &metav1.Duration{Duration: DefaultLeaseDuration}
+	}
+	if cfg.LeaderElection.RenewDeadline == nil {
+		cfg.LeaderElection.RenewDeadline = &metav1.Duration{Duration: DefaultRenewDeadline}
+	}
+	if cfg.LeaderElection.RetryPeriod == nil {
+		cfg.LeaderElection.RetryPeriod = &metav1.Duration{Duration: DefaultRetryPeriod}
+	}
+	if len(cfg.LeaderElection.ResourceLock) == 0 {
+		cfg.LeaderElection.ResourceLock = DefaultResourceLock
+	}
+	if len(cfg.LeaderElection.ResourceName) == 0 {
+		cfg.LeaderElection.ResourceName = DefaultLeaderElectionID
+	}
+
+	if cfg.CertManagement == nil {
+		cfg.CertManagement = &CertManagement{}
+	}
+	if cfg.CertManagement.Enable == nil {
+		cfg.CertManagement.Enable = ptr.To(true)
+	}
+	if len(cfg.CertManagement.WebhookServiceName) == 0 {
+		cfg.CertManagement.WebhookServiceName = DefaultWebhookServiceName
+	}
+	if len(cfg.CertManagement.WebhookSecretName) == 0 {
+		cfg.CertManagement.WebhookSecretName = DefaultWebhookSecretName
+	}
+
+	if cfg.Plugins == nil {
+		cfg.Plugins = &Plugins{}
+	}
+
+	if cfg.Tracing == nil {
+		cfg.Tracing = &Tracing{}
+	}
+	if len(cfg.Tracing.Exporter) == 0 {
+		cfg.Tracing.Exporter = TracingExporterNone
+	}
+	if cfg.Tracing.Insecure == nil {
+		cfg.Tracing.Insecure = ptr.To(false)
+	}
+	if cfg.Tracing.SamplingRatePerMillion == nil {
+		cfg.Tracing.SamplingRatePerMillion = ptr.To(DefaultSamplingRatePerMillion)
+	}
+}
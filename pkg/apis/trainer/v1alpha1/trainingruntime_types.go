@@ -37,6 +37,12 @@ const (
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Valid",type=string,JSONPath=`.status.conditions[?(@.type=="Valid")].status`
+// +kubebuilder:printcolumn:name="TrainJobs",type=integer,JSONPath=`.status.referencingTrainJobs`
+// +kubebuilder:printcolumn:name="Last Used",type=date,JSONPath=`.status.lastUsedTime`
+// +kubebuilder:printcolumn:name="Replaced By",type=string,JSONPath=`.spec.deprecation.replacedBy.name`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // ClusterTrainingRuntime represents a training runtime which can be referenced as part of
 // `runtimeRef` API in TrainJob. This resource is a cluster-scoped and can be referenced
@@ -49,6 +55,9 @@ type ClusterTrainingRuntime struct {
 
 	// Specification of the desired ClusterTrainingRuntime.
 	Spec TrainingRuntimeSpec `json:"spec,omitempty"`
+
+	// Current status of ClusterTrainingRuntime.
+	Status TrainingRuntimeStatus `json:"status,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -71,6 +80,12 @@ type ClusterTrainingRuntimeList struct {
 // +resource:path=trainingruntime
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Valid",type=string,JSONPath=`.status.conditions[?(@.type=="Valid")].status`
+// +kubebuilder:printcolumn:name="TrainJobs",type=integer,JSONPath=`.status.referencingTrainJobs`
+// +kubebuilder:printcolumn:name="Last Used",type=date,JSONPath=`.status.lastUsedTime`
+// +kubebuilder:printcolumn:name="Replaced By",type=string,JSONPath=`.spec.deprecation.replacedBy.name`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // TrainingRuntime represents a training runtime which can be referenced as part of
 // `runtimeRef` API in TrainJob. This resource is a namespaced-scoped and can be referenced
@@ -83,6 +98,9 @@ type TrainingRuntime struct {
 
 	// Specification of the desired TrainingRuntime.
 	Spec TrainingRuntimeSpec `json:"spec,omitempty"`
+
+	// Current status of TrainingRuntime.
+	Status TrainingRuntimeStatus `json:"status,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -100,6 +118,92 @@ type TrainingRuntimeList struct {
 	Items []TrainingRuntime `json:"items"`
 }
 
+const (
+	// TrainingRuntimeValid means that the runtime passes the validation of the runtime plugins,
+	// so the TrainJobs can be built from the runtime.
+	TrainingRuntimeValid string = "Valid"
+
+	// TrainingRuntimeDeprecated means that the runtime is deprecated and
+	// should not be referenced by the new TrainJobs.
+	TrainingRuntimeDeprecated string = "Deprecated"
+)
+
+const (
+	// TrainingRuntimeValidationSucceededReason is the "Valid" condition reason
+	// when the runtime passes the validation.
+	TrainingRuntimeValidationSucceededReason string = "ValidationSucceeded"
+
+	// TrainingRuntimeValidationFailedReason is the "Valid" condition reason
+	// when the runtime fails the validation.
+	TrainingRuntimeValidationFailedReason string = "ValidationFailed"
+
+	// TrainingRuntimeDeprecationRequestedReason is the "Deprecated" condition reason
+	// when the runtime has the deprecation in the spec.
+	TrainingRuntimeDeprecationRequestedReason string = "DeprecationRequested"
+)
+
+// RuntimeCapability represents a capability supported by the training runtime.
+type RuntimeCapability string
+
+const (
+	// RuntimeCapabilityTorch means that the runtime runs the PyTorch distributed training.
+	RuntimeCapabilityTorch RuntimeCapability = "Torch"
+
+	// RuntimeCapabilityMPI means that the runtime runs the MPI distributed training.
+	RuntimeCapabilityMPI RuntimeCapability = "MPI"
+
+	// RuntimeCapabilityJAX means that the runtime runs the JAX distributed training.
+	RuntimeCapabilityJAX RuntimeCapability = "JAX"
+
+	// RuntimeCapabilityTensorFlow means that the runtime runs the TensorFlow distributed training.
+	RuntimeCapabilityTensorFlow RuntimeCapability = "TensorFlow"
+
+	// RuntimeCapabilityXGBoost means that the runtime runs the XGBoost distributed training.
+	RuntimeCapabilityXGBoost RuntimeCapability = "XGBoost"
+
+	// RuntimeCapabilityDeepSpeed means that the runtime runs the DeepSpeed distributed training.
+	RuntimeCapabilityDeepSpeed RuntimeCapability = "DeepSpeed"
+
+	// RuntimeCapabilityElasticTraining means that the number of trainer nodes is scaled
+	// within the range of the PyTorch elastic policy.
+	RuntimeCapabilityElasticTraining RuntimeCapability = "ElasticTraining"
+
+	// RuntimeCapabilityGangScheduling means that the TrainJob Pods are gang-scheduled by the PodGroupPolicy.
+	RuntimeCapabilityGangScheduling RuntimeCapability = "GangScheduling"
+
+	// RuntimeCapabilityDatasetInitializer means that the runtime initializes the dataset before the training.
+	RuntimeCapabilityDatasetInitializer RuntimeCapability = "DatasetInitializer"
+
+	// RuntimeCapabilityModelInitializer means that the runtime initializes the model before the training.
+	RuntimeCapabilityModelInitializer RuntimeCapability = "ModelInitializer"
+)
+
+// TrainingRuntimeStatus represents the current status of the training runtime.
+type TrainingRuntimeStatus struct {
+	// Conditions for the runtime.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Number of TrainJobs referencing the runtime.
+	// +optional
+	ReferencingTrainJobs int32 `json:"referencingTrainJobs,omitempty"`
+
+	// Capabilities supported by the runtime, which are derived from the MLPolicy,
+	// the PodGroupPolicy, and the ancestors of the replicated Jobs.
+	// +listType=set
+	// +optional
+	Capabilities []RuntimeCapability `json:"capabilities,omitempty"`
+
+	// Last time a TrainJob referencing the runtime was created.
+	// +optional
+	LastUsedTime *metav1.Time `json:"lastUsedTime,omitempty"`
+}
+
 // TrainingRuntimeSpec represents a specification of the desired training runtime.
 type TrainingRuntimeSpec struct {
 	// Configuration for the model training with ML-specific parameters.
@@ -110,6 +214,23 @@ type TrainingRuntimeSpec struct {
 
 	// JobSet template which will be used by TrainJob.
 	Template JobSetTemplateSpec `json:"template"`
+
+	// Deprecation of the runtime.
+	// If set, the new TrainJobs referencing this runtime get the admission warning
+	// which points to the replacement runtime.
+	// The existing TrainJobs are not affected.
+	Deprecation *RuntimeDeprecation `json:"deprecation,omitempty"`
+}
+
+// RuntimeDeprecation represents the deprecation of the TrainingRuntime or ClusterTrainingRuntime.
+type RuntimeDeprecation struct {
+	// Reference to the runtime which replaces this runtime.
+	ReplacedBy *RuntimeRef `json:"replacedBy,omitempty"`
+
+	// Time after which the new TrainJobs referencing this runtime are rejected.
+	// The existing TrainJobs keep running after this time.
+	// If not set, the new TrainJobs are only warned.
+	RejectAfter *metav1.Time `json:"rejectAfter,omitempty"`
 }
 
 // JobSetTemplateSpec represents a template of the desired JobSet.
@@ -123,6 +244,7 @@ type JobSetTemplateSpec struct {
 }
 
 // PodGroupPolicy represents a PodGroup configuration for gang-scheduling.
+// +kubebuilder:validation:XValidation:rule="[has(self.coscheduling), has(self.volcano)].filter(x, x).size() <= 1", message="Only one of the podGroupPolicy can be configured"
 type PodGroupPolicy struct {
 	// Configuration for gang-scheduling using various plugins.
 	PodGroupPolicySource `json:",inline"`
@@ -134,7 +256,8 @@ type PodGroupPolicySource struct {
 	// Coscheduling plugin from the Kubernetes scheduler-plugins for gang-scheduling.
 	Coscheduling *CoschedulingPodGroupPolicySource `json:"coscheduling,omitempty"`
 
-	// TODO (andreyvelich): Add support for Volcano gang-scheduler.
+	// Volcano gang-scheduler for gang-scheduling.
+	Volcano *VolcanoPodGroupPolicySource `json:"volcano,omitempty"`
 }
 
 // CoschedulingPodGroupPolicySource represents configuration for coscheduling plugin.
@@ -147,15 +270,27 @@ type CoschedulingPodGroupPolicySource struct {
 	ScheduleTimeoutSeconds *int32 `json:"scheduleTimeoutSeconds,omitempty"`
 }
 
+// VolcanoPodGroupPolicySource represents configuration for the Volcano gang-scheduler.
+// The number of min members in the PodGroupSpec is always equal to the number of nodes.
+type VolcanoPodGroupPolicySource struct {
+	// Name of the Volcano queue to which the PodGroup is submitted.
+	// If not set, the Volcano default queue is used.
+	Queue *string `json:"queue,omitempty"`
+
+	// Name of the PriorityClass for the PodGroup.
+	// If not set, the Volcano scheduler uses the default priority.
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+}
+
 // MLPolicy represents configuration for the model trining with ML-specific parameters.
-// +kubebuilder:validation:XValidation:rule="!(has(self.numNodes) && (has(self.torch) && has(self.torch.elasticPolicy)))", message="numNodes should not be set if torch.elasticPolicy is configured"
-// +kubebuilder:validation:XValidation:rule="!(has(self.torch) && has(self.mpi))", message="Only one of the policy can be configured"
+// +kubebuilder:validation:XValidation:rule="!has(self.numNodes) || !has(self.torch) || !has(self.torch.elasticPolicy) || ((!has(self.torch.elasticPolicy.minNodes) || self.numNodes >= self.torch.elasticPolicy.minNodes) && (!has(self.torch.elasticPolicy.maxNodes) || self.numNodes <= self.torch.elasticPolicy.maxNodes))", message="numNodes must be within minNodes and maxNodes of torch.elasticPolicy"
+// +kubebuilder:validation:XValidation:rule="[has(self.torch), has(self.mpi), has(self.jax), has(self.tensorFlow), has(self.xgBoost), has(self.deepSpeed)].filter(x, x).size() <= 1", message="Only one of the policy can be configured"
 type MLPolicy struct {
 	// Number of training nodes.
 	// Defaults to 1.
 	NumNodes *int32 `json:"numNodes,omitempty"`
 
-	// Configuration for the runtime-specific parameters, such as Torch or MPI.
+	// Configuration for the runtime-specific parameters, such as Torch, MPI, JAX, TensorFlow, XGBoost, or DeepSpeed.
 	// Only one of its members may be specified.
 	MLPolicySource `json:",inline"`
 }
@@ -168,6 +303,18 @@ type MLPolicySource struct {
 
 	// Configuration for the MPI Runtime.
 	MPI *MPIMLPolicySource `json:"mpi,omitempty"`
+
+	// Configuration for the JAX Runtime.
+	JAX *JAXMLPolicySource `json:"jax,omitempty"`
+
+	// Configuration for the TensorFlow Runtime.
+	TensorFlow *TensorFlowMLPolicySource `json:"tensorFlow,omitempty"`
+
+	// Configuration for the XGBoost Runtime.
+	XGBoost *XGBoostMLPolicySource `json:"xgBoost,omitempty"`
+
+	// Configuration for the DeepSpeed Runtime.
+	DeepSpeed *DeepSpeedMLPolicySource `json:"deepSpeed,omitempty"`
 }
 
 // TorchMLPolicySource represents a PyTorch runtime configuration.
@@ -185,13 +332,15 @@ type TorchMLPolicySource struct {
 }
 
 // TorchElasticPolicy represents a configuration for the PyTorch elastic training.
-// If this policy is set, the `.spec.numNodes` parameter must be omitted, since min and max node
-// is used to configure the `torchrun` CLI argument: `--nnodes=minNodes:maxNodes`.
+// If this policy is set, the `.spec.numNodes` parameter is used as the initial number of nodes
+// and must be within the min and max nodes, since min and max node is used to configure
+// the `torchrun` CLI argument: `--nnodes=minNodes:maxNodes`.
 // Only `c10d` backend is supported for the Rendezvous communication.
+// +kubebuilder:validation:XValidation:rule="!has(self.minNodes) || !has(self.maxNodes) || self.minNodes <= self.maxNodes", message="minNodes must be less than or equal to maxNodes"
 type TorchElasticPolicy struct {
 	// How many times the training job can be restarted.
-	// This value is inserted into the `--max-restarts` argument of the `torchrun` CLI and
-	// the `.spec.failurePolicy.maxRestarts` parameter of the training Job.
+	// This value is inserted into the `--max-restarts` argument of the `torchrun` CLI.
+	// The restarts of the training Job are configured by the TrainJob `.spec.failurePolicy`.
 	MaxRestarts *int32 `json:"maxRestarts,omitempty"`
 
 	// Lower limit for the number of nodes to which training job can scale down.
@@ -202,11 +351,32 @@ type TorchElasticPolicy struct {
 
 	// Specification which are used to calculate the desired number of nodes. See the individual
 	// metric source types for more information about how each type of metric must respond.
-	// The HPA will be created to perform auto-scaling.
+	// The metrics-based auto-scaling is not supported yet, and the runtimes with the metrics are rejected
+	// since the trainer nodes can not be scaled while the TrainJob is running.
 	// +listType=atomic
 	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
 }
 
+// JAXMLPolicySource represents a JAX runtime configuration.
+// The coordinator address, the number of processes, and the process ID are
+// configured for the `jax.distributed.initialize()` API via the environment variables:
+// `JAX_COORDINATOR_ADDRESS`, `JAX_NUM_PROCESSES`, and `JAX_PROCESS_ID`.
+// Each training node runs a single JAX process.
+type JAXMLPolicySource struct{}
+
+// TensorFlowMLPolicySource represents a TensorFlow runtime configuration.
+// The cluster spec and the current task are configured via the `TF_CONFIG` environment variable.
+// The replicated Jobs are mapped to the TensorFlow task types by the
+// `trainer.kubeflow.org/trainjob-ancestor-step` label: `chief` for the chief,
+// `trainer` for the workers, and `ps` for the parameter servers.
+type TensorFlowMLPolicySource struct{}
+
+// XGBoostMLPolicySource represents a XGBoost runtime configuration.
+// The rank 0 node runs the Rabit tracker, and the tracker address, the number of workers,
+// and the task ID are configured via the `DMLC_TRACKER_URI`, `DMLC_TRACKER_PORT`,
+// `DMLC_NUM_WORKER`, and `DMLC_TASK_ID` environment variables.
+type XGBoostMLPolicySource struct{}
+
 // MPIMLPolicySource represents a MPI runtime configuration.
 type MPIMLPolicySource struct {
 	// Number of processes per node.
@@ -218,7 +388,7 @@ type MPIMLPolicySource struct {
 	// Implementation name for the MPI to create the appropriate hostfile.
 	// Defaults to OpenMPI.
 	// +kubebuilder:default=OpenMPI
-	// +kubebuilder:validation:Enum=OpenMPI
+	// +kubebuilder:validation:Enum=OpenMPI;Intel;MPICH
 	MPIImplementation *MPIImplementation `json:"mpiImplementation,omitempty"`
 
 	// Directory where SSH keys are mounted.
@@ -232,6 +402,27 @@ type MPIMLPolicySource struct {
 	RunLauncherAsNode *bool `json:"runLauncherAsNode,omitempty"`
 }
 
+// DeepSpeedMLPolicySource represents a DeepSpeed runtime configuration.
+// The DeepSpeed launcher connects to the training nodes via SSH with the generated hostfile.
+type DeepSpeedMLPolicySource struct {
+	// Number of processes per node.
+	// This value is equal to the number of slots for each node in the hostfile,
+	// and it is compatible with the `--num_gpus` argument of the `deepspeed` CLI.
+	// Defaults to 1.
+	// +kubebuilder:default=1
+	NumProcPerNode *int32 `json:"numProcPerNode,omitempty"`
+
+	// Directory where SSH keys are mounted.
+	// Defaults to /root/.ssh.
+	// +kubebuilder:default=/root/.ssh
+	SSHAuthMountPath *string `json:"sshAuthMountPath,omitempty"`
+
+	// Whether to run training process on the launcher Job.
+	// Defaults to false.
+	// +kubebuilder:default=false
+	RunLauncherAsNode *bool `json:"runLauncherAsNode,omitempty"`
+}
+
 // MPIImplementation represents one of the supported MPI implementations.
 type MPIImplementation string
 
@@ -27,6 +27,16 @@ const (
 	TrainJobKind string = "TrainJob"
 )
 
+const (
+	// TrainJobControllerName is the reserved value for the managedBy field
+	// to indicate that the TrainJob is reconciled by the built-in TrainJob controller.
+	TrainJobControllerName string = "trainer.kubeflow.org/trainjob-controller"
+
+	// MultiKueueControllerName is the value for the managedBy field
+	// to indicate that the TrainJob is delegated to the Kueue MultiKueue.
+	MultiKueueControllerName string = "kueue.x-k8s.io/multikueue"
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
@@ -50,9 +60,15 @@ type TrainJob struct {
 }
 
 const (
+	// TrainJobCreated means that the TrainJob resources have been created.
+	TrainJobCreated string = "Created"
+
 	// TrainJobSuspended means that TrainJob is suspended.
 	TrainJobSuspended string = "Suspended"
 
+	// TrainJobRunning means that all Pods of the trainer Jobs are running.
+	TrainJobRunning string = "Running"
+
 	// TrainJobComplete means that the TrainJob has completed its execution.
 	TrainJobComplete string = "Complete"
 
@@ -72,6 +88,30 @@ const (
 	// TrainJobRuntimeNotSupportedReason is the "Failed" condition reason
 	// when the referenced TrainingRuntime is not supported.
 	TrainJobRuntimeNotSupportedReason string = "TrainingRuntimeNotSupported"
+
+	// TrainJobDeadlineExceededReason is the "Failed" condition reason
+	// when the TrainJob has been active longer than the activeDeadlineSeconds.
+	TrainJobDeadlineExceededReason string = "DeadlineExceeded"
+
+	// TrainJobResourcesCreationFailedReason is the "Created" condition reason
+	// when the creation of the TrainJob resources failed.
+	TrainJobResourcesCreationFailedReason string = "ResourcesCreationFailed"
+
+	// TrainJobResourcesCreatedReason is the "Created" condition reason
+	// when the TrainJob resources have been successfully created.
+	TrainJobResourcesCreatedReason string = "ResourcesCreated"
+
+	// TrainJobPodsRunningReason is the "Running" condition reason
+	// when all Pods of the trainer Jobs are ready.
+	TrainJobPodsRunningReason string = "PodsRunning"
+
+	// TrainJobPodsPendingReason is the "Running" condition reason
+	// when the trainer Jobs are created, but some of their Pods are not ready yet.
+	TrainJobPodsPendingReason string = "PodsPending"
+
+	// TrainJobFinishedReason is the "Running" condition reason
+	// when the TrainJob has finished its execution.
+	TrainJobFinishedReason string = "Finished"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -130,6 +170,30 @@ type TrainJobSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self in ['trainer.kubeflow.org/trainjob-controller', 'kueue.x-k8s.io/multikueue']", message="ManagedBy must be trainer.kubeflow.org/trainjob-controller or kueue.x-k8s.io/multikueue if set"
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="ManagedBy value is immutable"
 	ManagedBy *string `json:"managedBy,omitempty"`
+
+	// FailurePolicy configures how the TrainJob handles the failures of its Jobs.
+	// When set, it overrides the failure policy of the runtime template.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="failurePolicy is immutable"
+	// +optional
+	FailurePolicy *FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// ActiveDeadlineSeconds is the duration in seconds that the TrainJob may be active
+	// before the controller tries to terminate it.
+	// Once reached, the TrainJob resources are suspended and the TrainJob is marked as Failed
+	// with the DeadlineExceeded reason. The time spent suspended doesn't count against the deadline,
+	// while the time the TrainJob was active before it was suspended does.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// TTLSecondsAfterFinished limits the lifetime of the TrainJob after it has finished
+	// execution (either Complete or Failed). When set, the TrainJob and its dependent resources
+	// are deleted once the TTL seconds have elapsed since the TrainJob completionTime.
+	// When this field is set to zero, the TrainJob becomes eligible to be deleted
+	// immediately after it finishes. When unset, the TrainJob is not automatically deleted.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
 }
 
 // RuntimeRef represents the reference to the existing training runtime.
@@ -268,6 +332,83 @@ type PodSpecOverride struct {
 	SchedulingGates []corev1.PodSchedulingGate `json:"schedulingGates,omitempty"`
 }
 
+// FailurePolicy represents the failure and restart policy of the TrainJob.
+type FailurePolicy struct {
+	// MaxRestarts is the limit on the number of TrainJob restarts.
+	// A restart is achieved by recreating all active Jobs of the TrainJob.
+	// Defaults to 0.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRestarts *int32 `json:"maxRestarts,omitempty"`
+
+	// Rules for the TrainJob failures. The rules are evaluated in order,
+	// and only the first matching rule is applied. If no rule matches,
+	// the TrainJob is restarted until the maxRestarts is reached.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=atomic
+	// +optional
+	Rules []FailurePolicyRule `json:"rules,omitempty"`
+}
+
+// FailurePolicyAction is the action taken when a FailurePolicyRule matches the TrainJob failure.
+// +kubebuilder:validation:Enum=Fail;Restart;RestartAndIgnoreMaxRestarts
+type FailurePolicyAction string
+
+const (
+	// FailurePolicyActionFail fails the TrainJob immediately, regardless of the maxRestarts.
+	FailurePolicyActionFail FailurePolicyAction = "Fail"
+
+	// FailurePolicyActionRestart restarts the TrainJob if the number of restarts is less than the maxRestarts.
+	FailurePolicyActionRestart FailurePolicyAction = "Restart"
+
+	// FailurePolicyActionRestartAndIgnoreMaxRestarts restarts the TrainJob without counting the failure against the maxRestarts.
+	FailurePolicyActionRestartAndIgnoreMaxRestarts FailurePolicyAction = "RestartAndIgnoreMaxRestarts"
+)
+
+// FailurePolicyRule represents the requirement on the TrainJob failure and the action taken when it is met.
+// At most one of onExitCodes, onPodDisruption, and onJobFailureReasons can be set.
+// When none of them is set, the rule applies to any Job failure.
+// +kubebuilder:validation:XValidation:rule="[has(self.onExitCodes), has(self.onPodDisruption) && self.onPodDisruption, has(self.onJobFailureReasons)].filter(x, x).size() <= 1", message="at most one of onExitCodes, onPodDisruption, and onJobFailureReasons can be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.onExitCodes) || self.action == 'Fail'", message="onExitCodes can only be used with the Fail action"
+// +kubebuilder:validation:XValidation:rule="!has(self.onPodDisruption) || !self.onPodDisruption || self.action == 'RestartAndIgnoreMaxRestarts'", message="onPodDisruption can only be used with the RestartAndIgnoreMaxRestarts action"
+type FailurePolicyRule struct {
+	// Action to take when the rule matches the TrainJob failure.
+	Action FailurePolicyAction `json:"action"`
+
+	// OnExitCodes is the list of container exit codes which fail the TrainJob.
+	// The Pod failure with one of the exit codes fails its Job immediately.
+	// It can only be used with the Fail action.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=255
+	// +kubebuilder:validation:items:Minimum=1
+	// +kubebuilder:validation:items:Maximum=255
+	// +listType=set
+	// +optional
+	OnExitCodes []int32 `json:"onExitCodes,omitempty"`
+
+	// OnPodDisruption matches the Pod failures caused by disruptions, like Pod eviction,
+	// preemption, or node shutdown. The disrupted Pods are recreated by their Jobs without
+	// counting the failure against the Job backoffLimit and the TrainJob maxRestarts.
+	// It can only be used with the RestartAndIgnoreMaxRestarts action.
+	// +optional
+	OnPodDisruption bool `json:"onPodDisruption,omitempty"`
+
+	// OnJobFailureReasons is the list of Job failure reasons which match the rule,
+	// e.g. BackoffLimitExceeded, DeadlineExceeded, or PodFailurePolicy.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	// +optional
+	OnJobFailureReasons []string `json:"onJobFailureReasons,omitempty"`
+
+	// TargetJobs is the list of Job names from the runtime template which the rule applies to.
+	// An empty list applies the rule to all Jobs, except for the onExitCodes and onPodDisruption rules
+	// which apply to the trainer node Job only. Those rules set the Never restartPolicy
+	// for the Pods of the targeted Jobs, so other Jobs, like initializers, must be listed explicitly.
+	// +listType=set
+	// +optional
+	TargetJobs []string `json:"targetJobs,omitempty"`
+}
+
 type PodSpecOverrideTargetJob struct {
 	// Name is the target training job name for which the PodSpec is overridden.
 	Name string `json:"name"`
@@ -307,6 +448,54 @@ type TrainJobStatus struct {
 	// +listType=map
 	// +listMapKey=name
 	JobsStatus []JobStatus `json:"jobsStatus,omitempty"`
+
+	// Time when the TrainJob was started to run, that is when the TrainJob resources
+	// are created and the TrainJob is not suspended.
+	// The value is reset when the TrainJob is suspended, and the time the TrainJob was active
+	// until then is accumulated in the activeSeconds.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Number of seconds the TrainJob was active before its latest suspension.
+	// The value is accumulated every time the TrainJob is suspended, and it counts
+	// against the activeDeadlineSeconds after the TrainJob is resumed.
+	// +optional
+	ActiveSeconds *int64 `json:"activeSeconds,omitempty"`
+
+	// Time when the TrainJob has finished its execution with either Complete or Failed condition.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Number of consecutive failed attempts to create the TrainJob resources.
+	// The TrainJob is backed off between the attempts, and the value is reset
+	// once the resources are successfully created.
+	// +optional
+	ResourcesCreationRetries *int32 `json:"resourcesCreationRetries,omitempty"`
+
+	// Last time the controller failed to create the TrainJob resources.
+	// The value is reset once the resources are successfully created.
+	// +optional
+	LastResourcesCreationAttemptTime *metav1.Time `json:"lastResourcesCreationAttemptTime,omitempty"`
+
+	// Number of times the TrainJob has been restarted by the failure policy.
+	// +optional
+	Restarts *int32 `json:"restarts,omitempty"`
+
+	// Snapshot of the runtime spec which is used to build the TrainJob resources.
+	// The snapshot is taken at the first reconciliation, so the later updates to the runtime
+	// don't affect the TrainJob unless the TrainJob is annotated with
+	// `trainer.kubeflow.org/re-resolve-runtime` to be re-resolved to the latest runtime.
+	// +optional
+	RuntimeSnapshot *RuntimeSnapshot `json:"runtimeSnapshot,omitempty"`
+}
+
+// RuntimeSnapshot represents the snapshot of the runtime spec pinned to the TrainJob.
+type RuntimeSnapshot struct {
+	// Name of the ControllerRevision which stores the runtime spec.
+	Name string `json:"name"`
+
+	// Generation of the runtime when the snapshot was taken.
+	RuntimeGeneration int64 `json:"runtimeGeneration"`
 }
 
 type JobStatus struct {
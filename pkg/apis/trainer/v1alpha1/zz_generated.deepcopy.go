@@ -33,6 +33,7 @@ func (in *ClusterTrainingRuntime) DeepCopyInto(out *ClusterTrainingRuntime) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -171,6 +172,96 @@ func (in *DatasetInitializer) DeepCopy() *DatasetInitializer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeepSpeedMLPolicySource) DeepCopyInto(out *DeepSpeedMLPolicySource) {
+	*out = *in
+	if in.NumProcPerNode != nil {
+		in, out := &in.NumProcPerNode, &out.NumProcPerNode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SSHAuthMountPath != nil {
+		in, out := &in.SSHAuthMountPath, &out.SSHAuthMountPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.RunLauncherAsNode != nil {
+		in, out := &in.RunLauncherAsNode, &out.RunLauncherAsNode
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeepSpeedMLPolicySource.
+func (in *DeepSpeedMLPolicySource) DeepCopy() *DeepSpeedMLPolicySource {
+	if in == nil {
+		return nil
+	}
+	out := new(DeepSpeedMLPolicySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailurePolicy) DeepCopyInto(out *FailurePolicy) {
+	*out = *in
+	if in.MaxRestarts != nil {
+		in, out := &in.MaxRestarts, &out.MaxRestarts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]FailurePolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePolicy.
+func (in *FailurePolicy) DeepCopy() *FailurePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FailurePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailurePolicyRule) DeepCopyInto(out *FailurePolicyRule) {
+	*out = *in
+	if in.OnExitCodes != nil {
+		in, out := &in.OnExitCodes, &out.OnExitCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.OnJobFailureReasons != nil {
+		in, out := &in.OnJobFailureReasons, &out.OnJobFailureReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetJobs != nil {
+		in, out := &in.TargetJobs, &out.TargetJobs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePolicyRule.
+func (in *FailurePolicyRule) DeepCopy() *FailurePolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FailurePolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Initializer) DeepCopyInto(out *Initializer) {
 	*out = *in
@@ -197,6 +288,22 @@ func (in *Initializer) DeepCopy() *Initializer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JAXMLPolicySource) DeepCopyInto(out *JAXMLPolicySource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JAXMLPolicySource.
+func (in *JAXMLPolicySource) DeepCopy() *JAXMLPolicySource {
+	if in == nil {
+		return nil
+	}
+	out := new(JAXMLPolicySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JobSetTemplateSpec) DeepCopyInto(out *JobSetTemplateSpec) {
 	*out = *in
@@ -266,6 +373,26 @@ func (in *MLPolicySource) DeepCopyInto(out *MLPolicySource) {
 		*out = new(MPIMLPolicySource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.JAX != nil {
+		in, out := &in.JAX, &out.JAX
+		*out = new(JAXMLPolicySource)
+		**out = **in
+	}
+	if in.TensorFlow != nil {
+		in, out := &in.TensorFlow, &out.TensorFlow
+		*out = new(TensorFlowMLPolicySource)
+		**out = **in
+	}
+	if in.XGBoost != nil {
+		in, out := &in.XGBoost, &out.XGBoost
+		*out = new(XGBoostMLPolicySource)
+		**out = **in
+	}
+	if in.DeepSpeed != nil {
+		in, out := &in.DeepSpeed, &out.DeepSpeed
+		*out = new(DeepSpeedMLPolicySource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -373,6 +500,11 @@ func (in *PodGroupPolicySource) DeepCopyInto(out *PodGroupPolicySource) {
 		*out = new(CoschedulingPodGroupPolicySource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Volcano != nil {
+		in, out := &in.Volcano, &out.Volcano
+		*out = new(VolcanoPodGroupPolicySource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -468,6 +600,31 @@ func (in *PodSpecOverrideTargetJob) DeepCopy() *PodSpecOverrideTargetJob {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeDeprecation) DeepCopyInto(out *RuntimeDeprecation) {
+	*out = *in
+	if in.ReplacedBy != nil {
+		in, out := &in.ReplacedBy, &out.ReplacedBy
+		*out = new(RuntimeRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RejectAfter != nil {
+		in, out := &in.RejectAfter, &out.RejectAfter
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeDeprecation.
+func (in *RuntimeDeprecation) DeepCopy() *RuntimeDeprecation {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeDeprecation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuntimeRef) DeepCopyInto(out *RuntimeRef) {
 	*out = *in
@@ -494,6 +651,38 @@ func (in *RuntimeRef) DeepCopy() *RuntimeRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeSnapshot) DeepCopyInto(out *RuntimeSnapshot) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeSnapshot.
+func (in *RuntimeSnapshot) DeepCopy() *RuntimeSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TensorFlowMLPolicySource) DeepCopyInto(out *TensorFlowMLPolicySource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TensorFlowMLPolicySource.
+func (in *TensorFlowMLPolicySource) DeepCopy() *TensorFlowMLPolicySource {
+	if in == nil {
+		return nil
+	}
+	out := new(TensorFlowMLPolicySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TorchElasticPolicy) DeepCopyInto(out *TorchElasticPolicy) {
 	*out = *in
@@ -664,6 +853,21 @@ func (in *TrainJobSpec) DeepCopyInto(out *TrainJobSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -692,6 +896,38 @@ func (in *TrainJobStatus) DeepCopyInto(out *TrainJobStatus) {
 		*out = make([]JobStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ActiveSeconds != nil {
+		in, out := &in.ActiveSeconds, &out.ActiveSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ResourcesCreationRetries != nil {
+		in, out := &in.ResourcesCreationRetries, &out.ResourcesCreationRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LastResourcesCreationAttemptTime != nil {
+		in, out := &in.LastResourcesCreationAttemptTime, &out.LastResourcesCreationAttemptTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Restarts != nil {
+		in, out := &in.Restarts, &out.Restarts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RuntimeSnapshot != nil {
+		in, out := &in.RuntimeSnapshot, &out.RuntimeSnapshot
+		*out = new(RuntimeSnapshot)
+		**out = **in
+	}
 	return
 }
 
@@ -764,6 +1000,7 @@ func (in *TrainingRuntime) DeepCopyInto(out *TrainingRuntime) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -832,6 +1069,11 @@ func (in *TrainingRuntimeSpec) DeepCopyInto(out *TrainingRuntimeSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Template.DeepCopyInto(&out.Template)
+	if in.Deprecation != nil {
+		in, out := &in.Deprecation, &out.Deprecation
+		*out = new(RuntimeDeprecation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -844,3 +1086,77 @@ func (in *TrainingRuntimeSpec) DeepCopy() *TrainingRuntimeSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrainingRuntimeStatus) DeepCopyInto(out *TrainingRuntimeStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]RuntimeCapability, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUsedTime != nil {
+		in, out := &in.LastUsedTime, &out.LastUsedTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrainingRuntimeStatus.
+func (in *TrainingRuntimeStatus) DeepCopy() *TrainingRuntimeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrainingRuntimeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolcanoPodGroupPolicySource) DeepCopyInto(out *VolcanoPodGroupPolicySource) {
+	*out = *in
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(string)
+		**out = **in
+	}
+	if in.PriorityClassName != nil {
+		in, out := &in.PriorityClassName, &out.PriorityClassName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolcanoPodGroupPolicySource.
+func (in *VolcanoPodGroupPolicySource) DeepCopy() *VolcanoPodGroupPolicySource {
+	if in == nil {
+		return nil
+	}
+	out := new(VolcanoPodGroupPolicySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XGBoostMLPolicySource) DeepCopyInto(out *XGBoostMLPolicySource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XGBoostMLPolicySource.
+func (in *XGBoostMLPolicySource) DeepCopy() *XGBoostMLPolicySource {
+	if in == nil {
+		return nil
+	}
+	out := new(XGBoostMLPolicySource)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -27,7 +27,8 @@ import (
 type ClusterTrainingRuntimeApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *TrainingRuntimeSpecApplyConfiguration `json:"spec,omitempty"`
+	Spec                             *TrainingRuntimeSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *TrainingRuntimeStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // ClusterTrainingRuntime constructs a declarative configuration of the ClusterTrainingRuntime type for use with
@@ -206,6 +207,14 @@ func (b *ClusterTrainingRuntimeApplyConfiguration) WithSpec(value *TrainingRunti
 	return b
 }
 
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *ClusterTrainingRuntimeApplyConfiguration) WithStatus(value *TrainingRuntimeStatusApplyConfiguration) *ClusterTrainingRuntimeApplyConfiguration {
+	b.Status = value
+	return b
+}
+
 // GetName retrieves the value of the Name field in the declarative configuration.
 func (b *ClusterTrainingRuntimeApplyConfiguration) GetName() *string {
 	b.ensureObjectMetaApplyConfigurationExists()
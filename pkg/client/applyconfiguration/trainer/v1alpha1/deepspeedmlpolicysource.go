@@ -0,0 +1,55 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// DeepSpeedMLPolicySourceApplyConfiguration represents a declarative configuration of the DeepSpeedMLPolicySource type for use
+// with apply.
+type DeepSpeedMLPolicySourceApplyConfiguration struct {
+	NumProcPerNode    *int32  `json:"numProcPerNode,omitempty"`
+	SSHAuthMountPath  *string `json:"sshAuthMountPath,omitempty"`
+	RunLauncherAsNode *bool   `json:"runLauncherAsNode,omitempty"`
+}
+
+// DeepSpeedMLPolicySourceApplyConfiguration constructs a declarative configuration of the DeepSpeedMLPolicySource type for use with
+// apply.
+func DeepSpeedMLPolicySource() *DeepSpeedMLPolicySourceApplyConfiguration {
+	return &DeepSpeedMLPolicySourceApplyConfiguration{}
+}
+
+// WithNumProcPerNode sets the NumProcPerNode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NumProcPerNode field is set to the value of the last call.
+func (b *DeepSpeedMLPolicySourceApplyConfiguration) WithNumProcPerNode(value int32) *DeepSpeedMLPolicySourceApplyConfiguration {
+	b.NumProcPerNode = &value
+	return b
+}
+
+// WithSSHAuthMountPath sets the SSHAuthMountPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SSHAuthMountPath field is set to the value of the last call.
+func (b *DeepSpeedMLPolicySourceApplyConfiguration) WithSSHAuthMountPath(value string) *DeepSpeedMLPolicySourceApplyConfiguration {
+	b.SSHAuthMountPath = &value
+	return b
+}
+
+// WithRunLauncherAsNode sets the RunLauncherAsNode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RunLauncherAsNode field is set to the value of the last call.
+func (b *DeepSpeedMLPolicySourceApplyConfiguration) WithRunLauncherAsNode(value bool) *DeepSpeedMLPolicySourceApplyConfiguration {
+	b.RunLauncherAsNode = &value
+	return b
+}
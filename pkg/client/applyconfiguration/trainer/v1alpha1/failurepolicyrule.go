@@ -0,0 +1,83 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	trainerv1alpha1 "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+)
+
+// FailurePolicyRuleApplyConfiguration represents a declarative configuration of the FailurePolicyRule type for use
+// with apply.
+type FailurePolicyRuleApplyConfiguration struct {
+	Action              *trainerv1alpha1.FailurePolicyAction `json:"action,omitempty"`
+	OnExitCodes         []int32                              `json:"onExitCodes,omitempty"`
+	OnPodDisruption     *bool                                `json:"onPodDisruption,omitempty"`
+	OnJobFailureReasons []string                             `json:"onJobFailureReasons,omitempty"`
+	TargetJobs          []string                             `json:"targetJobs,omitempty"`
+}
+
+// FailurePolicyRuleApplyConfiguration constructs a declarative configuration of the FailurePolicyRule type for use with
+// apply.
+func FailurePolicyRule() *FailurePolicyRuleApplyConfiguration {
+	return &FailurePolicyRuleApplyConfiguration{}
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *FailurePolicyRuleApplyConfiguration) WithAction(value trainerv1alpha1.FailurePolicyAction) *FailurePolicyRuleApplyConfiguration {
+	b.Action = &value
+	return b
+}
+
+// WithOnExitCodes adds the given value to the OnExitCodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OnExitCodes field.
+func (b *FailurePolicyRuleApplyConfiguration) WithOnExitCodes(values ...int32) *FailurePolicyRuleApplyConfiguration {
+	for i := range values {
+		b.OnExitCodes = append(b.OnExitCodes, values[i])
+	}
+	return b
+}
+
+// WithOnPodDisruption sets the OnPodDisruption field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OnPodDisruption field is set to the value of the last call.
+func (b *FailurePolicyRuleApplyConfiguration) WithOnPodDisruption(value bool) *FailurePolicyRuleApplyConfiguration {
+	b.OnPodDisruption = &value
+	return b
+}
+
+// WithOnJobFailureReasons adds the given value to the OnJobFailureReasons field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OnJobFailureReasons field.
+func (b *FailurePolicyRuleApplyConfiguration) WithOnJobFailureReasons(values ...string) *FailurePolicyRuleApplyConfiguration {
+	for i := range values {
+		b.OnJobFailureReasons = append(b.OnJobFailureReasons, values[i])
+	}
+	return b
+}
+
+// WithTargetJobs adds the given value to the TargetJobs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TargetJobs field.
+func (b *FailurePolicyRuleApplyConfiguration) WithTargetJobs(values ...string) *FailurePolicyRuleApplyConfiguration {
+	for i := range values {
+		b.TargetJobs = append(b.TargetJobs, values[i])
+	}
+	return b
+}
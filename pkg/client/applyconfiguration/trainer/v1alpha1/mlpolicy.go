@@ -16,6 +16,10 @@
 
 package v1alpha1
 
+import (
+	trainerv1alpha1 "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+)
+
 // MLPolicyApplyConfiguration represents a declarative configuration of the MLPolicy type for use
 // with apply.
 type MLPolicyApplyConfiguration struct {
@@ -52,3 +56,35 @@ func (b *MLPolicyApplyConfiguration) WithMPI(value *MPIMLPolicySourceApplyConfig
 	b.MLPolicySourceApplyConfiguration.MPI = value
 	return b
 }
+
+// WithJAX sets the JAX field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JAX field is set to the value of the last call.
+func (b *MLPolicyApplyConfiguration) WithJAX(value trainerv1alpha1.JAXMLPolicySource) *MLPolicyApplyConfiguration {
+	b.MLPolicySourceApplyConfiguration.JAX = &value
+	return b
+}
+
+// WithTensorFlow sets the TensorFlow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TensorFlow field is set to the value of the last call.
+func (b *MLPolicyApplyConfiguration) WithTensorFlow(value trainerv1alpha1.TensorFlowMLPolicySource) *MLPolicyApplyConfiguration {
+	b.MLPolicySourceApplyConfiguration.TensorFlow = &value
+	return b
+}
+
+// WithXGBoost sets the XGBoost field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the XGBoost field is set to the value of the last call.
+func (b *MLPolicyApplyConfiguration) WithXGBoost(value trainerv1alpha1.XGBoostMLPolicySource) *MLPolicyApplyConfiguration {
+	b.MLPolicySourceApplyConfiguration.XGBoost = &value
+	return b
+}
+
+// WithDeepSpeed sets the DeepSpeed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeepSpeed field is set to the value of the last call.
+func (b *MLPolicyApplyConfiguration) WithDeepSpeed(value *DeepSpeedMLPolicySourceApplyConfiguration) *MLPolicyApplyConfiguration {
+	b.MLPolicySourceApplyConfiguration.DeepSpeed = value
+	return b
+}
@@ -16,11 +16,19 @@
 
 package v1alpha1
 
+import (
+	trainerv1alpha1 "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+)
+
 // MLPolicySourceApplyConfiguration represents a declarative configuration of the MLPolicySource type for use
 // with apply.
 type MLPolicySourceApplyConfiguration struct {
-	Torch *TorchMLPolicySourceApplyConfiguration `json:"torch,omitempty"`
-	MPI   *MPIMLPolicySourceApplyConfiguration   `json:"mpi,omitempty"`
+	Torch      *TorchMLPolicySourceApplyConfiguration     `json:"torch,omitempty"`
+	MPI        *MPIMLPolicySourceApplyConfiguration       `json:"mpi,omitempty"`
+	JAX        *trainerv1alpha1.JAXMLPolicySource         `json:"jax,omitempty"`
+	TensorFlow *trainerv1alpha1.TensorFlowMLPolicySource  `json:"tensorFlow,omitempty"`
+	XGBoost    *trainerv1alpha1.XGBoostMLPolicySource     `json:"xgBoost,omitempty"`
+	DeepSpeed  *DeepSpeedMLPolicySourceApplyConfiguration `json:"deepSpeed,omitempty"`
 }
 
 // MLPolicySourceApplyConfiguration constructs a declarative configuration of the MLPolicySource type for use with
@@ -44,3 +52,35 @@ func (b *MLPolicySourceApplyConfiguration) WithMPI(value *MPIMLPolicySourceApply
 	b.MPI = value
 	return b
 }
+
+// WithJAX sets the JAX field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JAX field is set to the value of the last call.
+func (b *MLPolicySourceApplyConfiguration) WithJAX(value trainerv1alpha1.JAXMLPolicySource) *MLPolicySourceApplyConfiguration {
+	b.JAX = &value
+	return b
+}
+
+// WithTensorFlow sets the TensorFlow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TensorFlow field is set to the value of the last call.
+func (b *MLPolicySourceApplyConfiguration) WithTensorFlow(value trainerv1alpha1.TensorFlowMLPolicySource) *MLPolicySourceApplyConfiguration {
+	b.TensorFlow = &value
+	return b
+}
+
+// WithXGBoost sets the XGBoost field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the XGBoost field is set to the value of the last call.
+func (b *MLPolicySourceApplyConfiguration) WithXGBoost(value trainerv1alpha1.XGBoostMLPolicySource) *MLPolicySourceApplyConfiguration {
+	b.XGBoost = &value
+	return b
+}
+
+// WithDeepSpeed sets the DeepSpeed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeepSpeed field is set to the value of the last call.
+func (b *MLPolicySourceApplyConfiguration) WithDeepSpeed(value *DeepSpeedMLPolicySourceApplyConfiguration) *MLPolicySourceApplyConfiguration {
+	b.DeepSpeed = value
+	return b
+}
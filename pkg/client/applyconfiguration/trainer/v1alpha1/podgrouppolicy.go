@@ -35,3 +35,11 @@ func (b *PodGroupPolicyApplyConfiguration) WithCoscheduling(value *CoschedulingP
 	b.PodGroupPolicySourceApplyConfiguration.Coscheduling = value
 	return b
 }
+
+// WithVolcano sets the Volcano field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Volcano field is set to the value of the last call.
+func (b *PodGroupPolicyApplyConfiguration) WithVolcano(value *VolcanoPodGroupPolicySourceApplyConfiguration) *PodGroupPolicyApplyConfiguration {
+	b.PodGroupPolicySourceApplyConfiguration.Volcano = value
+	return b
+}
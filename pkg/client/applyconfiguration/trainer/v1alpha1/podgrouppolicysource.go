@@ -20,6 +20,7 @@ package v1alpha1
 // with apply.
 type PodGroupPolicySourceApplyConfiguration struct {
 	Coscheduling *CoschedulingPodGroupPolicySourceApplyConfiguration `json:"coscheduling,omitempty"`
+	Volcano      *VolcanoPodGroupPolicySourceApplyConfiguration      `json:"volcano,omitempty"`
 }
 
 // PodGroupPolicySourceApplyConfiguration constructs a declarative configuration of the PodGroupPolicySource type for use with
@@ -35,3 +36,11 @@ func (b *PodGroupPolicySourceApplyConfiguration) WithCoscheduling(value *Cosched
 	b.Coscheduling = value
 	return b
 }
+
+// WithVolcano sets the Volcano field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Volcano field is set to the value of the last call.
+func (b *PodGroupPolicySourceApplyConfiguration) WithVolcano(value *VolcanoPodGroupPolicySourceApplyConfiguration) *PodGroupPolicySourceApplyConfiguration {
+	b.Volcano = value
+	return b
+}
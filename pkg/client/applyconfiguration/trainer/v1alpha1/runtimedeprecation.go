@@ -0,0 +1,50 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RuntimeDeprecationApplyConfiguration represents a declarative configuration of the RuntimeDeprecation type for use
+// with apply.
+type RuntimeDeprecationApplyConfiguration struct {
+	ReplacedBy  *RuntimeRefApplyConfiguration `json:"replacedBy,omitempty"`
+	RejectAfter *v1.Time                      `json:"rejectAfter,omitempty"`
+}
+
+// RuntimeDeprecationApplyConfiguration constructs a declarative configuration of the RuntimeDeprecation type for use with
+// apply.
+func RuntimeDeprecation() *RuntimeDeprecationApplyConfiguration {
+	return &RuntimeDeprecationApplyConfiguration{}
+}
+
+// WithReplacedBy sets the ReplacedBy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplacedBy field is set to the value of the last call.
+func (b *RuntimeDeprecationApplyConfiguration) WithReplacedBy(value *RuntimeRefApplyConfiguration) *RuntimeDeprecationApplyConfiguration {
+	b.ReplacedBy = value
+	return b
+}
+
+// WithRejectAfter sets the RejectAfter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RejectAfter field is set to the value of the last call.
+func (b *RuntimeDeprecationApplyConfiguration) WithRejectAfter(value v1.Time) *RuntimeDeprecationApplyConfiguration {
+	b.RejectAfter = &value
+	return b
+}
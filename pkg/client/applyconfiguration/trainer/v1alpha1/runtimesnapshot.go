@@ -0,0 +1,46 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// RuntimeSnapshotApplyConfiguration represents a declarative configuration of the RuntimeSnapshot type for use
+// with apply.
+type RuntimeSnapshotApplyConfiguration struct {
+	Name              *string `json:"name,omitempty"`
+	RuntimeGeneration *int64  `json:"runtimeGeneration,omitempty"`
+}
+
+// RuntimeSnapshotApplyConfiguration constructs a declarative configuration of the RuntimeSnapshot type for use with
+// apply.
+func RuntimeSnapshot() *RuntimeSnapshotApplyConfiguration {
+	return &RuntimeSnapshotApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *RuntimeSnapshotApplyConfiguration) WithName(value string) *RuntimeSnapshotApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithRuntimeGeneration sets the RuntimeGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RuntimeGeneration field is set to the value of the last call.
+func (b *RuntimeSnapshotApplyConfiguration) WithRuntimeGeneration(value int64) *RuntimeSnapshotApplyConfiguration {
+	b.RuntimeGeneration = &value
+	return b
+}
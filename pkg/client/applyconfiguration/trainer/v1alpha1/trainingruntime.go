@@ -27,7 +27,8 @@ import (
 type TrainingRuntimeApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *TrainingRuntimeSpecApplyConfiguration `json:"spec,omitempty"`
+	Spec                             *TrainingRuntimeSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *TrainingRuntimeStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // TrainingRuntime constructs a declarative configuration of the TrainingRuntime type for use with
@@ -207,6 +208,14 @@ func (b *TrainingRuntimeApplyConfiguration) WithSpec(value *TrainingRuntimeSpecA
 	return b
 }
 
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *TrainingRuntimeApplyConfiguration) WithStatus(value *TrainingRuntimeStatusApplyConfiguration) *TrainingRuntimeApplyConfiguration {
+	b.Status = value
+	return b
+}
+
 // GetName retrieves the value of the Name field in the declarative configuration.
 func (b *TrainingRuntimeApplyConfiguration) GetName() *string {
 	b.ensureObjectMetaApplyConfigurationExists()
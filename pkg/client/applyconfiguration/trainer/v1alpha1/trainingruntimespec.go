@@ -22,6 +22,7 @@ type TrainingRuntimeSpecApplyConfiguration struct {
 	MLPolicy       *MLPolicyApplyConfiguration           `json:"mlPolicy,omitempty"`
 	PodGroupPolicy *PodGroupPolicyApplyConfiguration     `json:"podGroupPolicy,omitempty"`
 	Template       *JobSetTemplateSpecApplyConfiguration `json:"template,omitempty"`
+	Deprecation    *RuntimeDeprecationApplyConfiguration `json:"deprecation,omitempty"`
 }
 
 // TrainingRuntimeSpecApplyConfiguration constructs a declarative configuration of the TrainingRuntimeSpec type for use with
@@ -53,3 +54,11 @@ func (b *TrainingRuntimeSpecApplyConfiguration) WithTemplate(value *JobSetTempla
 	b.Template = value
 	return b
 }
+
+// WithDeprecation sets the Deprecation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Deprecation field is set to the value of the last call.
+func (b *TrainingRuntimeSpecApplyConfiguration) WithDeprecation(value *RuntimeDeprecationApplyConfiguration) *TrainingRuntimeSpecApplyConfiguration {
+	b.Deprecation = value
+	return b
+}
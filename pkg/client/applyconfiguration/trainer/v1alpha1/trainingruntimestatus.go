@@ -0,0 +1,77 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	trainerv1alpha1 "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// TrainingRuntimeStatusApplyConfiguration represents a declarative configuration of the TrainingRuntimeStatus type for use
+// with apply.
+type TrainingRuntimeStatusApplyConfiguration struct {
+	Conditions           []v1.ConditionApplyConfiguration    `json:"conditions,omitempty"`
+	ReferencingTrainJobs *int32                              `json:"referencingTrainJobs,omitempty"`
+	Capabilities         []trainerv1alpha1.RuntimeCapability `json:"capabilities,omitempty"`
+	LastUsedTime         *metav1.Time                        `json:"lastUsedTime,omitempty"`
+}
+
+// TrainingRuntimeStatusApplyConfiguration constructs a declarative configuration of the TrainingRuntimeStatus type for use with
+// apply.
+func TrainingRuntimeStatus() *TrainingRuntimeStatusApplyConfiguration {
+	return &TrainingRuntimeStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *TrainingRuntimeStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *TrainingRuntimeStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithReferencingTrainJobs sets the ReferencingTrainJobs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReferencingTrainJobs field is set to the value of the last call.
+func (b *TrainingRuntimeStatusApplyConfiguration) WithReferencingTrainJobs(value int32) *TrainingRuntimeStatusApplyConfiguration {
+	b.ReferencingTrainJobs = &value
+	return b
+}
+
+// WithCapabilities adds the given value to the Capabilities field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Capabilities field.
+func (b *TrainingRuntimeStatusApplyConfiguration) WithCapabilities(values ...trainerv1alpha1.RuntimeCapability) *TrainingRuntimeStatusApplyConfiguration {
+	for i := range values {
+		b.Capabilities = append(b.Capabilities, values[i])
+	}
+	return b
+}
+
+// WithLastUsedTime sets the LastUsedTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUsedTime field is set to the value of the last call.
+func (b *TrainingRuntimeStatusApplyConfiguration) WithLastUsedTime(value metav1.Time) *TrainingRuntimeStatusApplyConfiguration {
+	b.LastUsedTime = &value
+	return b
+}
@@ -19,14 +19,17 @@ package v1alpha1
 // TrainJobSpecApplyConfiguration represents a declarative configuration of the TrainJobSpec type for use
 // with apply.
 type TrainJobSpecApplyConfiguration struct {
-	RuntimeRef       *RuntimeRefApplyConfiguration       `json:"runtimeRef,omitempty"`
-	Initializer      *InitializerApplyConfiguration      `json:"initializer,omitempty"`
-	Trainer          *TrainerApplyConfiguration          `json:"trainer,omitempty"`
-	Labels           map[string]string                   `json:"labels,omitempty"`
-	Annotations      map[string]string                   `json:"annotations,omitempty"`
-	PodSpecOverrides []PodSpecOverrideApplyConfiguration `json:"podSpecOverrides,omitempty"`
-	Suspend          *bool                               `json:"suspend,omitempty"`
-	ManagedBy        *string                             `json:"managedBy,omitempty"`
+	RuntimeRef              *RuntimeRefApplyConfiguration       `json:"runtimeRef,omitempty"`
+	Initializer             *InitializerApplyConfiguration      `json:"initializer,omitempty"`
+	Trainer                 *TrainerApplyConfiguration          `json:"trainer,omitempty"`
+	Labels                  map[string]string                   `json:"labels,omitempty"`
+	Annotations             map[string]string                   `json:"annotations,omitempty"`
+	PodSpecOverrides        []PodSpecOverrideApplyConfiguration `json:"podSpecOverrides,omitempty"`
+	Suspend                 *bool                               `json:"suspend,omitempty"`
+	ManagedBy               *string                             `json:"managedBy,omitempty"`
+	FailurePolicy           *FailurePolicyApplyConfiguration    `json:"failurePolicy,omitempty"`
+	ActiveDeadlineSeconds   *int64                              `json:"activeDeadlineSeconds,omitempty"`
+	TTLSecondsAfterFinished *int32                              `json:"ttlSecondsAfterFinished,omitempty"`
 }
 
 // TrainJobSpecApplyConfiguration constructs a declarative configuration of the TrainJobSpec type for use with
@@ -115,3 +118,27 @@ func (b *TrainJobSpecApplyConfiguration) WithManagedBy(value string) *TrainJobSp
 	b.ManagedBy = &value
 	return b
 }
+
+// WithFailurePolicy sets the FailurePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailurePolicy field is set to the value of the last call.
+func (b *TrainJobSpecApplyConfiguration) WithFailurePolicy(value *FailurePolicyApplyConfiguration) *TrainJobSpecApplyConfiguration {
+	b.FailurePolicy = value
+	return b
+}
+
+// WithActiveDeadlineSeconds sets the ActiveDeadlineSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveDeadlineSeconds field is set to the value of the last call.
+func (b *TrainJobSpecApplyConfiguration) WithActiveDeadlineSeconds(value int64) *TrainJobSpecApplyConfiguration {
+	b.ActiveDeadlineSeconds = &value
+	return b
+}
+
+// WithTTLSecondsAfterFinished sets the TTLSecondsAfterFinished field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TTLSecondsAfterFinished field is set to the value of the last call.
+func (b *TrainJobSpecApplyConfiguration) WithTTLSecondsAfterFinished(value int32) *TrainJobSpecApplyConfiguration {
+	b.TTLSecondsAfterFinished = &value
+	return b
+}
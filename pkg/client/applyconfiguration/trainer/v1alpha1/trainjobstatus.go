@@ -17,14 +17,22 @@
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // TrainJobStatusApplyConfiguration represents a declarative configuration of the TrainJobStatus type for use
 // with apply.
 type TrainJobStatusApplyConfiguration struct {
-	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
-	JobsStatus []JobStatusApplyConfiguration    `json:"jobsStatus,omitempty"`
+	Conditions                       []v1.ConditionApplyConfiguration   `json:"conditions,omitempty"`
+	JobsStatus                       []JobStatusApplyConfiguration      `json:"jobsStatus,omitempty"`
+	StartTime                        *metav1.Time                       `json:"startTime,omitempty"`
+	ActiveSeconds                    *int64                             `json:"activeSeconds,omitempty"`
+	CompletionTime                   *metav1.Time                       `json:"completionTime,omitempty"`
+	ResourcesCreationRetries         *int32                             `json:"resourcesCreationRetries,omitempty"`
+	LastResourcesCreationAttemptTime *metav1.Time                       `json:"lastResourcesCreationAttemptTime,omitempty"`
+	Restarts                         *int32                             `json:"restarts,omitempty"`
+	RuntimeSnapshot                  *RuntimeSnapshotApplyConfiguration `json:"runtimeSnapshot,omitempty"`
 }
 
 // TrainJobStatusApplyConfiguration constructs a declarative configuration of the TrainJobStatus type for use with
@@ -58,3 +66,59 @@ func (b *TrainJobStatusApplyConfiguration) WithJobsStatus(values ...*JobStatusAp
 	}
 	return b
 }
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *TrainJobStatusApplyConfiguration) WithStartTime(value metav1.Time) *TrainJobStatusApplyConfiguration {
+	b.StartTime = &value
+	return b
+}
+
+// WithActiveSeconds sets the ActiveSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveSeconds field is set to the value of the last call.
+func (b *TrainJobStatusApplyConfiguration) WithActiveSeconds(value int64) *TrainJobStatusApplyConfiguration {
+	b.ActiveSeconds = &value
+	return b
+}
+
+// WithCompletionTime sets the CompletionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletionTime field is set to the value of the last call.
+func (b *TrainJobStatusApplyConfiguration) WithCompletionTime(value metav1.Time) *TrainJobStatusApplyConfiguration {
+	b.CompletionTime = &value
+	return b
+}
+
+// WithResourcesCreationRetries sets the ResourcesCreationRetries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourcesCreationRetries field is set to the value of the last call.
+func (b *TrainJobStatusApplyConfiguration) WithResourcesCreationRetries(value int32) *TrainJobStatusApplyConfiguration {
+	b.ResourcesCreationRetries = &value
+	return b
+}
+
+// WithLastResourcesCreationAttemptTime sets the LastResourcesCreationAttemptTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastResourcesCreationAttemptTime field is set to the value of the last call.
+func (b *TrainJobStatusApplyConfiguration) WithLastResourcesCreationAttemptTime(value metav1.Time) *TrainJobStatusApplyConfiguration {
+	b.LastResourcesCreationAttemptTime = &value
+	return b
+}
+
+// WithRestarts sets the Restarts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Restarts field is set to the value of the last call.
+func (b *TrainJobStatusApplyConfiguration) WithRestarts(value int32) *TrainJobStatusApplyConfiguration {
+	b.Restarts = &value
+	return b
+}
+
+// WithRuntimeSnapshot sets the RuntimeSnapshot field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RuntimeSnapshot field is set to the value of the last call.
+func (b *TrainJobStatusApplyConfiguration) WithRuntimeSnapshot(value *RuntimeSnapshotApplyConfiguration) *TrainJobStatusApplyConfiguration {
+	b.RuntimeSnapshot = value
+	return b
+}
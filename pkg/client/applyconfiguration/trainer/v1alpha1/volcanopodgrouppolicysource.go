@@ -0,0 +1,46 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// VolcanoPodGroupPolicySourceApplyConfiguration represents a declarative configuration of the VolcanoPodGroupPolicySource type for use
+// with apply.
+type VolcanoPodGroupPolicySourceApplyConfiguration struct {
+	Queue             *string `json:"queue,omitempty"`
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+}
+
+// VolcanoPodGroupPolicySourceApplyConfiguration constructs a declarative configuration of the VolcanoPodGroupPolicySource type for use with
+// apply.
+func VolcanoPodGroupPolicySource() *VolcanoPodGroupPolicySourceApplyConfiguration {
+	return &VolcanoPodGroupPolicySourceApplyConfiguration{}
+}
+
+// WithQueue sets the Queue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Queue field is set to the value of the last call.
+func (b *VolcanoPodGroupPolicySourceApplyConfiguration) WithQueue(value string) *VolcanoPodGroupPolicySourceApplyConfiguration {
+	b.Queue = &value
+	return b
+}
+
+// WithPriorityClassName sets the PriorityClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClassName field is set to the value of the last call.
+func (b *VolcanoPodGroupPolicySourceApplyConfiguration) WithPriorityClassName(value string) *VolcanoPodGroupPolicySourceApplyConfiguration {
+	b.PriorityClassName = &value
+	return b
+}
@@ -38,6 +38,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &trainerv1alpha1.CoschedulingPodGroupPolicySourceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DatasetInitializer"):
 		return &trainerv1alpha1.DatasetInitializerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DeepSpeedMLPolicySource"):
+		return &trainerv1alpha1.DeepSpeedMLPolicySourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FailurePolicy"):
+		return &trainerv1alpha1.FailurePolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FailurePolicyRule"):
+		return &trainerv1alpha1.FailurePolicyRuleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Initializer"):
 		return &trainerv1alpha1.InitializerApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("JobSetTemplateSpec"):
@@ -60,8 +66,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &trainerv1alpha1.PodSpecOverrideApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PodSpecOverrideTargetJob"):
 		return &trainerv1alpha1.PodSpecOverrideTargetJobApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RuntimeDeprecation"):
+		return &trainerv1alpha1.RuntimeDeprecationApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RuntimeRef"):
 		return &trainerv1alpha1.RuntimeRefApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RuntimeSnapshot"):
+		return &trainerv1alpha1.RuntimeSnapshotApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TorchElasticPolicy"):
 		return &trainerv1alpha1.TorchElasticPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TorchMLPolicySource"):
@@ -72,12 +82,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &trainerv1alpha1.TrainingRuntimeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TrainingRuntimeSpec"):
 		return &trainerv1alpha1.TrainingRuntimeSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TrainingRuntimeStatus"):
+		return &trainerv1alpha1.TrainingRuntimeStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TrainJob"):
 		return &trainerv1alpha1.TrainJobApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TrainJobSpec"):
 		return &trainerv1alpha1.TrainJobSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TrainJobStatus"):
 		return &trainerv1alpha1.TrainJobStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolcanoPodGroupPolicySource"):
+		return &trainerv1alpha1.VolcanoPodGroupPolicySourceApplyConfiguration{}
 
 	}
 	return nil
@@ -38,6 +38,8 @@ type ClusterTrainingRuntimesGetter interface {
 type ClusterTrainingRuntimeInterface interface {
 	Create(ctx context.Context, clusterTrainingRuntime *trainerv1alpha1.ClusterTrainingRuntime, opts v1.CreateOptions) (*trainerv1alpha1.ClusterTrainingRuntime, error)
 	Update(ctx context.Context, clusterTrainingRuntime *trainerv1alpha1.ClusterTrainingRuntime, opts v1.UpdateOptions) (*trainerv1alpha1.ClusterTrainingRuntime, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, clusterTrainingRuntime *trainerv1alpha1.ClusterTrainingRuntime, opts v1.UpdateOptions) (*trainerv1alpha1.ClusterTrainingRuntime, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*trainerv1alpha1.ClusterTrainingRuntime, error)
@@ -45,6 +47,8 @@ type ClusterTrainingRuntimeInterface interface {
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *trainerv1alpha1.ClusterTrainingRuntime, err error)
 	Apply(ctx context.Context, clusterTrainingRuntime *applyconfigurationtrainerv1alpha1.ClusterTrainingRuntimeApplyConfiguration, opts v1.ApplyOptions) (result *trainerv1alpha1.ClusterTrainingRuntime, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, clusterTrainingRuntime *applyconfigurationtrainerv1alpha1.ClusterTrainingRuntimeApplyConfiguration, opts v1.ApplyOptions) (result *trainerv1alpha1.ClusterTrainingRuntime, err error)
 	ClusterTrainingRuntimeExpansion
 }
 
@@ -38,6 +38,8 @@ type TrainingRuntimesGetter interface {
 type TrainingRuntimeInterface interface {
 	Create(ctx context.Context, trainingRuntime *trainerv1alpha1.TrainingRuntime, opts v1.CreateOptions) (*trainerv1alpha1.TrainingRuntime, error)
 	Update(ctx context.Context, trainingRuntime *trainerv1alpha1.TrainingRuntime, opts v1.UpdateOptions) (*trainerv1alpha1.TrainingRuntime, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, trainingRuntime *trainerv1alpha1.TrainingRuntime, opts v1.UpdateOptions) (*trainerv1alpha1.TrainingRuntime, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*trainerv1alpha1.TrainingRuntime, error)
@@ -45,6 +47,8 @@ type TrainingRuntimeInterface interface {
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *trainerv1alpha1.TrainingRuntime, err error)
 	Apply(ctx context.Context, trainingRuntime *applyconfigurationtrainerv1alpha1.TrainingRuntimeApplyConfiguration, opts v1.ApplyOptions) (result *trainerv1alpha1.TrainingRuntime, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, trainingRuntime *applyconfigurationtrainerv1alpha1.TrainingRuntimeApplyConfiguration, opts v1.ApplyOptions) (result *trainerv1alpha1.TrainingRuntime, err error)
 	TrainingRuntimeExpansion
 }
 
@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlconfig "sigs.k8s.io/controller-runtime/pkg/config"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+)
+
+// Load returns the manager options and the Configuration read from the configFile.
+// When the configFile is empty, the defaulted Configuration is used.
+// The webhook server is not set in the options since it depends on the TLS options owned by the caller.
+func Load(scheme *runtime.Scheme, configFile string) (ctrl.Options, configapi.Configuration, error) {
+	options := ctrl.Options{
+		Scheme: scheme,
+	}
+	cfg := configapi.Configuration{}
+	if len(configFile) == 0 {
+		scheme.Default(&cfg)
+	} else if err := fromFile(configFile, scheme, &cfg); err != nil {
+		return options, cfg, err
+	}
+	if errs := validate(&cfg); len(errs) != 0 {
+		return options, cfg, errs.ToAggregate()
+	}
+	addTo(&options, &cfg)
+	return options, cfg, nil
+}
+
+func fromFile(path string, scheme *runtime.Scheme, cfg *configapi.Configuration) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file %q: %w", path, err)
+	}
+	codecs := serializer.NewCodecFactory(scheme, serializer.EnableStrict)
+	// The strict decoder rejects unknown and duplicated fields.
+	if err = runtime.DecodeInto(codecs.UniversalDecoder(), content, cfg); err != nil {
+		return fmt.Errorf("decoding config file %q: %w", path, err)
+	}
+	return nil
+}
+
+func addTo(o *ctrl.Options, cfg *configapi.Configuration) {
+	o.Metrics = metricsserver.Options{
+		BindAddress:   cfg.Metrics.BindAddress,
+		SecureServing: ptr.Deref(cfg.Metrics.SecureServing, true),
+	}
+	o.HealthProbeBindAddress = cfg.Health.HealthProbeBindAddress
+	o.ReadinessEndpointName = cfg.Health.ReadinessEndpointName
+	o.LivenessEndpointName = cfg.Health.LivenessEndpointName
+
+	if le := cfg.LeaderElection; le != nil {
+		o.LeaderElection = ptr.Deref(le.LeaderElect, false)
+		o.LeaderElectionResourceLock = le.ResourceLock
+		o.LeaderElectionNamespace = le.ResourceNamespace
+		o.LeaderElectionID = le.ResourceName
+		if le.LeaseDuration != nil {
+			o.LeaseDuration = ptr.To(le.LeaseDuration.Duration)
+		}
+		if le.RenewDeadline != nil {
+			o.RenewDeadline = ptr.To(le.RenewDeadline.Duration)
+		}
+		if le.RetryPeriod != nil {
+			o.RetryPeriod = ptr.To(le.RetryPeriod.Duration)
+		}
+	}
+
+	if ctrlCfg := cfg.Controller; ctrlCfg != nil {
+		o.Controller = ctrlconfig.Controller{
+			GroupKindConcurrency: ctrlCfg.GroupKindConcurrency,
+		}
+		if ctrlCfg.CacheSyncTimeout != nil {
+			o.Controller.CacheSyncTimeout = ctrlCfg.CacheSyncTimeout.Duration
+		}
+	}
+}
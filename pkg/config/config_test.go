@@ -0,0 +1,307 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlconfig "sigs.k8s.io/controller-runtime/pkg/config"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+)
+
+func TestLoad(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(configapi.AddToScheme(scheme))
+
+	tmpDir := t.TempDir()
+	writeConfig := func(name, content string) string {
+		path := filepath.Join(tmpDir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	defaultConfig := configapi.Configuration{}
+	configapi.SetDefaults_Configuration(&defaultConfig)
+	defaultOptions := ctrl.Options{
+		Scheme: scheme,
+		Metrics: metricsserver.Options{
+			BindAddress:   configapi.DefaultMetricsBindAddress,
+			SecureServing: true,
+		},
+		HealthProbeBindAddress:     configapi.DefaultHealthProbeBindAddress,
+		ReadinessEndpointName:      configapi.DefaultReadinessEndpoint,
+		LivenessEndpointName:       configapi.DefaultLivenessEndpoint,
+		LeaderElectionResourceLock: configapi.DefaultResourceLock,
+		LeaderElectionID:           configapi.DefaultLeaderElectionID,
+		LeaseDuration:              ptr.To(configapi.DefaultLeaseDuration),
+		RenewDeadline:              ptr.To(configapi.DefaultRenewDeadline),
+		RetryPeriod:                ptr.To(configapi.DefaultRetryPeriod),
+	}
+
+	cases := map[string]struct {
+		configFile  string
+		wantConfig  configapi.Configuration
+		wantOptions ctrl.Options
+		wantErr     bool
+	}{
+		"default configuration is used when config file is not specified": {
+			wantConfig:  defaultConfig,
+			wantOptions: defaultOptions,
+		},
+		"configuration is loaded from file and defaulted": {
+			configFile: writeConfig("config.yaml", `
+apiVersion: config.trainer.kubeflow.org/v1alpha1
+kind: Configuration
+webhook:
+  port: 9444
+leaderElection:
+  leaderElect: true
+  resourceNamespace: kubeflow-system
+metrics:
+  bindAddress: :8443
+controller:
+  groupKindConcurrency:
+    TrainJob.trainer.kubeflow.org: 5
+certManagement:
+  enable: false
+plugins:
+  enabled:
+  - Volcano
+  disabled:
+  - XGBoost
+tracing:
+  exporter: OTLP
+  endpoint: otel-collector:4317
+  insecure: true
+`),
+			wantConfig: configapi.Configuration{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: configapi.GroupVersion.String(),
+					Kind:       configapi.ConfigurationKind,
+				},
+				ControllerManager: configapi.ControllerManager{
+					Webhook: configapi.ControllerWebhook{
+						Port: ptr.To[int32](9444),
+					},
+					LeaderElection: &configapi.LeaderElection{
+						LeaderElect:       ptr.To(true),
+						LeaseDuration:     &metav1.Duration{Duration: configapi.DefaultLeaseDuration},
+						RenewDeadline:     &metav1.Duration{Duration: configapi.DefaultRenewDeadline},
+						RetryPeriod:       &metav1.Duration{Duration: configapi.DefaultRetryPeriod},
+						ResourceLock:      configapi.DefaultResourceLock,
+						ResourceName:      configapi.DefaultLeaderElectionID,
+						ResourceNamespace: "kubeflow-system",
+					},
+					Metrics: configapi.ControllerMetrics{
+						BindAddress:   ":8443",
+						SecureServing: ptr.To(true),
+					},
+					Health: configapi.ControllerHealth{
+						HealthProbeBindAddress: configapi.DefaultHealthProbeBindAddress,
+						ReadinessEndpointName:  configapi.DefaultReadinessEndpoint,
+						LivenessEndpointName:   configapi.DefaultLivenessEndpoint,
+					},
+					Controller: &configapi.ControllerConfigurationSpec{
+						GroupKindConcurrency: map[string]int{
+							"TrainJob.trainer.kubeflow.org": 5,
+						},
+					},
+				},
+				CertManagement: &configapi.CertManagement{
+					Enable:             ptr.To(false),
+					WebhookServiceName: configapi.DefaultWebhookServiceName,
+					WebhookSecretName:  configapi.DefaultWebhookSecretName,
+				},
+				Plugins: &configapi.Plugins{
+					Enabled:  []string{"Volcano"},
+					Disabled: []string{"XGBoost"},
+				},
+				Tracing: &configapi.Tracing{
+					Exporter:               configapi.TracingExporterOTLP,
+					Endpoint:               "otel-collector:4317",
+					Insecure:               ptr.To(true),
+					SamplingRatePerMillion: ptr.To(configapi.DefaultSamplingRatePerMillion),
+				},
+			},
+			wantOptions: ctrl.Options{
+				Scheme: scheme,
+				Metrics: metricsserver.Options{
+					BindAddress:   ":8443",
+					SecureServing: true,
+				},
+				HealthProbeBindAddress:     configapi.DefaultHealthProbeBindAddress,
+				ReadinessEndpointName:      configapi.DefaultReadinessEndpoint,
+				LivenessEndpointName:       configapi.DefaultLivenessEndpoint,
+				LeaderElection:             true,
+				LeaderElectionResourceLock: configapi.DefaultResourceLock,
+				LeaderElectionNamespace:    "kubeflow-system",
+				LeaderElectionID:           configapi.DefaultLeaderElectionID,
+				LeaseDuration:              ptr.To(configapi.DefaultLeaseDuration),
+				RenewDeadline:              ptr.To(configapi.DefaultRenewDeadline),
+				RetryPeriod:                ptr.To(configapi.DefaultRetryPeriod),
+				Controller: ctrlconfig.Controller{
+					GroupKindConcurrency: map[string]int{
+						"TrainJob.trainer.kubeflow.org": 5,
+					},
+				},
+			},
+		},
+		"unknown fields are rejected": {
+			configFile: writeConfig("unknown.yaml", `
+apiVersion: config.trainer.kubeflow.org/v1alpha1
+kind: Configuration
+unknownField: true
+`),
+			wantErr: true,
+		},
+		"unknown plugins are rejected": {
+			configFile: writeConfig("plugins.yaml", `
+apiVersion: config.trainer.kubeflow.org/v1alpha1
+kind: Configuration
+plugins:
+  enabled:
+  - Unknown
+`),
+			wantErr: true,
+		},
+		"missing config file": {
+			configFile: filepath.Join(tmpDir, "missing.yaml"),
+			wantErr:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			options, cfg, err := Load(scheme, tc.configFile)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.wantConfig, cfg); len(diff) != 0 {
+				t.Errorf("Unexpected configuration (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantOptions, options, cmpopts.IgnoreUnexported(ctrl.Options{}), cmpopts.IgnoreTypes(runtime.Scheme{}, logr.Logger{}, net.ListenConfig{})); len(diff) != 0 {
+				t.Errorf("Unexpected options (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cases := map[string]struct {
+		cfg        *configapi.Configuration
+		wantErrLen int
+	}{
+		"valid configuration": {
+			cfg: &configapi.Configuration{
+				Plugins: &configapi.Plugins{
+					Enabled: []string{"Volcano"},
+				},
+			},
+		},
+		"invalid webhook port": {
+			cfg: &configapi.Configuration{
+				ControllerManager: configapi.ControllerManager{
+					Webhook: configapi.ControllerWebhook{Port: ptr.To[int32](0)},
+				},
+			},
+			wantErrLen: 1,
+		},
+		"leaseDuration must be greater than renewDeadline": {
+			cfg: &configapi.Configuration{
+				ControllerManager: configapi.ControllerManager{
+					LeaderElection: &configapi.LeaderElection{
+						LeaderElect:   ptr.To(true),
+						LeaseDuration: &metav1.Duration{Duration: 10 * time.Second},
+						RenewDeadline: &metav1.Duration{Duration: 10 * time.Second},
+						ResourceName:  configapi.DefaultLeaderElectionID,
+					},
+				},
+			},
+			wantErrLen: 1,
+		},
+		"non-positive concurrency": {
+			cfg: &configapi.Configuration{
+				ControllerManager: configapi.ControllerManager{
+					Controller: &configapi.ControllerConfigurationSpec{
+						GroupKindConcurrency: map[string]int{"TrainJob.trainer.kubeflow.org": 0},
+					},
+				},
+			},
+			wantErrLen: 1,
+		},
+		"plugin is both enabled and disabled": {
+			cfg: &configapi.Configuration{
+				Plugins: &configapi.Plugins{
+					Enabled:  []string{"Volcano"},
+					Disabled: []string{"Volcano", "Unknown"},
+				},
+			},
+			wantErrLen: 2,
+		},
+		"required plugin is disabled": {
+			cfg: &configapi.Configuration{
+				Plugins: &configapi.Plugins{
+					Disabled: []string{"JobSet"},
+				},
+			},
+			wantErrLen: 1,
+		},
+		"OTLP exporter without endpoint": {
+			cfg: &configapi.Configuration{
+				Tracing: &configapi.Tracing{
+					Exporter: configapi.TracingExporterOTLP,
+				},
+			},
+			wantErrLen: 1,
+		},
+		"unknown exporter and invalid sampling rate": {
+			cfg: &configapi.Configuration{
+				Tracing: &configapi.Tracing{
+					Exporter:               "Zipkin",
+					SamplingRatePerMillion: ptr.To[int32](1000001),
+				},
+			},
+			wantErrLen: 2,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			errs := validate(tc.cfg)
+			if len(errs) != tc.wantErrLen {
+				t.Errorf("Unexpected number of errors, want %d, got %d: %v", tc.wantErrLen, len(errs), errs)
+			}
+		})
+	}
+}
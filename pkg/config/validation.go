@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+	fwkplugins "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset"
+)
+
+var (
+	webhookPortPath          = field.NewPath("webhook", "port")
+	leaderElectionPath       = field.NewPath("leaderElection")
+	groupKindConcurrencyPath = field.NewPath("controller", "groupKindConcurrency")
+	pluginsPath              = field.NewPath("plugins")
+	tracingPath              = field.NewPath("tracing")
+)
+
+// requiredPlugins can not be disabled since the TrainJob can not be reconciled without them.
+var requiredPlugins = sets.New(jobset.Name)
+
+func validate(cfg *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	if port := ptr.Deref(cfg.Webhook.Port, configapi.DefaultWebhookPort); port < 1 || port > 65535 {
+		allErrs = append(allErrs, field.Invalid(webhookPortPath, port, "must be between 1 and 65535"))
+	}
+	allErrs = append(allErrs, validateLeaderElection(cfg.LeaderElection)...)
+	if cfg.Controller != nil {
+		for gk, concurrency := range cfg.Controller.GroupKindConcurrency {
+			if concurrency <= 0 {
+				allErrs = append(allErrs, field.Invalid(groupKindConcurrencyPath.Key(gk), concurrency, "must be greater than 0"))
+			}
+		}
+	}
+	allErrs = append(allErrs, validatePlugins(cfg.Plugins)...)
+	allErrs = append(allErrs, validateTracing(cfg.Tracing)...)
+	return allErrs
+}
+
+func validateLeaderElection(le *configapi.LeaderElection) field.ErrorList {
+	var allErrs field.ErrorList
+	if le == nil || !ptr.Deref(le.LeaderElect, false) {
+		return allErrs
+	}
+	if le.LeaseDuration != nil && le.RenewDeadline != nil && le.LeaseDuration.Duration <= le.RenewDeadline.Duration {
+		allErrs = append(allErrs, field.Invalid(leaderElectionPath.Child("leaseDuration"), le.LeaseDuration.Duration.String(),
+			"must be greater than renewDeadline"))
+	}
+	if len(le.ResourceName) == 0 {
+		allErrs = append(allErrs, field.Required(leaderElectionPath.Child("resourceName"), "must be set when leaderElect is true"))
+	}
+	return allErrs
+}
+
+func validatePlugins(plugins *configapi.Plugins) field.ErrorList {
+	var allErrs field.ErrorList
+	if plugins == nil {
+		return allErrs
+	}
+	knownPlugins := sets.KeySet(fwkplugins.NewRegistry())
+	supported := sets.List(knownPlugins)
+	for i, name := range plugins.Enabled {
+		if !knownPlugins.Has(name) {
+			allErrs = append(allErrs, field.NotSupported(pluginsPath.Child("enabled").Index(i), name, supported))
+		}
+	}
+	for i, name := range plugins.Disabled {
+		if !knownPlugins.Has(name) {
+			allErrs = append(allErrs, field.NotSupported(pluginsPath.Child("disabled").Index(i), name, supported))
+		}
+		if slices.Contains(plugins.Enabled, name) {
+			allErrs = append(allErrs, field.Invalid(pluginsPath.Child("disabled").Index(i), name,
+				fmt.Sprintf("plugin %q can not be both enabled and disabled", name)))
+		}
+		if requiredPlugins.Has(name) {
+			allErrs = append(allErrs, field.Invalid(pluginsPath.Child("disabled").Index(i), name,
+				fmt.Sprintf("plugin %q is required and can not be disabled", name)))
+		}
+	}
+	return allErrs
+}
+
+func validateTracing(tracing *configapi.Tracing) field.ErrorList {
+	var allErrs field.ErrorList
+	if tracing == nil {
+		return allErrs
+	}
+	switch tracing.Exporter {
+	case "", configapi.TracingExporterNone, configapi.TracingExporterStdout:
+	case configapi.TracingExporterOTLP:
+		if len(tracing.Endpoint) == 0 {
+			allErrs = append(allErrs, field.Required(tracingPath.Child("endpoint"), "must be set when exporter is OTLP"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(tracingPath.Child("exporter"), tracing.Exporter, []configapi.TracingExporter{
+			configapi.TracingExporterNone, configapi.TracingExporterStdout, configapi.TracingExporterOTLP,
+		}))
+	}
+	if rate := ptr.Deref(tracing.SamplingRatePerMillion, configapi.DefaultSamplingRatePerMillion); rate < 0 || rate > 1000000 {
+		allErrs = append(allErrs, field.Invalid(tracingPath.Child("samplingRatePerMillion"), rate, "must be between 0 and 1000000"))
+	}
+	return allErrs
+}
@@ -22,8 +22,41 @@ const (
 	// trainer.kubeflow.org/trainjob-ancestor-step: dataset-initializer  - trainJob.spec.initializer.dataset
 	// trainer.kubeflow.org/trainjob-ancestor-step: model-initializer    - trainJob.spec.initializer.model
 	// trainer.kubeflow.org/trainjob-ancestor-step: trainer              - trainJob.spec.trainer
+	// trainer.kubeflow.org/trainjob-ancestor-step: chief                - TensorFlow chief
+	// trainer.kubeflow.org/trainjob-ancestor-step: ps                   - TensorFlow parameter server
 	LabelTrainJobAncestor string = "trainer.kubeflow.org/trainjob-ancestor-step"
 
+	// LabelTrainJobName is the label to identify the TrainJob which owns the ControllerRevision
+	// with the runtime spec snapshot.
+	LabelTrainJobName string = "trainer.kubeflow.org/trainjob-name"
+
+	// AnnotationReResolveRuntime is the TrainJob annotation to re-resolve the runtime spec snapshot
+	// to the latest runtime. The annotation is removed by the controller once the TrainJob is re-resolved.
+	AnnotationReResolveRuntime string = "trainer.kubeflow.org/re-resolve-runtime"
+
+	// AnnotationSuccessfulTrainJobsHistoryLimit is the Namespace annotation to limit the number of
+	// Complete TrainJobs which are retained per runtime in the Namespace. The oldest TrainJobs are deleted first
+	// when a TrainJob with the same runtime finishes.
+	AnnotationSuccessfulTrainJobsHistoryLimit string = "trainer.kubeflow.org/successful-trainjobs-history-limit"
+
+	// AnnotationFailedTrainJobsHistoryLimit is the Namespace annotation to limit the number of
+	// Failed TrainJobs which are retained per runtime in the Namespace. The oldest TrainJobs are deleted first
+	// when a TrainJob with the same runtime finishes.
+	AnnotationFailedTrainJobsHistoryLimit string = "trainer.kubeflow.org/failed-trainjobs-history-limit"
+
+	// AnnotationDefaultRuntime is the Namespace annotation to specify the runtime used by the TrainJobs
+	// which are created without the runtimeRef name. The value is either `<name>` or `<kind>/<name>`,
+	// and the kind defaults to ClusterTrainingRuntime.
+	AnnotationDefaultRuntime string = "trainer.kubeflow.org/default-runtime"
+
+	// AnnotationDefaultTrainJobLabels is the Namespace annotation to specify the JSON-encoded labels
+	// which are added to the TrainJobs created in the Namespace unless the TrainJob already has them.
+	AnnotationDefaultTrainJobLabels string = "trainer.kubeflow.org/default-trainjob-labels"
+
+	// AnnotationDefaultTrainJobAnnotations is the Namespace annotation to specify the JSON-encoded annotations
+	// which are added to the TrainJobs created in the Namespace unless the TrainJob already has them.
+	AnnotationDefaultTrainJobAnnotations string = "trainer.kubeflow.org/default-trainjob-annotations"
+
 	// DatasetInitializer is the name of the Job, volume mount, container, and label value for the dataset initializer.
 	DatasetInitializer string = "dataset-initializer"
 
@@ -40,6 +73,14 @@ const (
 	// 'trainer.kubeflow.org/trainjob-ancestor-step'.
 	AncestorTrainer string = "trainer"
 
+	// AncestorChief is the ancestor name for the TensorFlow chief, which is used for the value of
+	// 'trainer.kubeflow.org/trainjob-ancestor-step'.
+	AncestorChief string = "chief"
+
+	// AncestorParameterServer is the ancestor name for the TensorFlow parameter server, which is used for the value of
+	// 'trainer.kubeflow.org/trainjob-ancestor-step'.
+	AncestorParameterServer string = "ps"
+
 	// Node is the name of the Job and container for the trainer node
 	Node string = "node"
 
@@ -57,6 +98,34 @@ const (
 	// {"type": "Suspended", "status": "True", "reason": "Resumed"} condition.
 	TrainJobResumedMessage = "TrainJob is resumed"
 
+	// TrainJobResourcesCreatedMessage is status condition message for the
+	// {"type": "Created", "status": "True", "reason": "ResourcesCreated"} condition.
+	TrainJobResourcesCreatedMessage = "TrainJob resources are created"
+
+	// TrainJobPodsRunningMessage is status condition message for the
+	// {"type": "Running", "status": "True", "reason": "PodsRunning"} condition.
+	TrainJobPodsRunningMessage = "TrainJob trainer Pods are running"
+
+	// TrainJobPodsPendingMessage is status condition message for the
+	// {"type": "Running", "status": "False", "reason": "PodsPending"} condition.
+	TrainJobPodsPendingMessage = "TrainJob trainer Pods are not ready"
+
+	// TrainJobRunningSuspendedMessage is status condition message for the
+	// {"type": "Running", "status": "False", "reason": "Suspended"} condition.
+	TrainJobRunningSuspendedMessage = "TrainJob is not running since it is suspended"
+
+	// TrainJobFinishedMessage is status condition message for the
+	// {"type": "Running", "status": "False", "reason": "Finished"} condition.
+	TrainJobFinishedMessage = "TrainJob has finished"
+
+	// TrainJobDeadlineExceededMessage is status condition message for the
+	// "Failed" condition type when the TrainJob has exceeded the activeDeadlineSeconds.
+	TrainJobDeadlineExceededMessage = "TrainJob was active longer than the specified deadline"
+
+	// TrainingRuntimeValidationSucceededMessage is status condition message for the
+	// {"type": "Valid", "status": "True", "reason": "ValidationSucceeded"} condition.
+	TrainingRuntimeValidationSucceededMessage = "Runtime is valid"
+
 	// Node is the name of the Job and container for the MPI launcher.
 	// When RunLauncherAsNode: true, for the launcher Job the container name is node.
 	Launcher string = "launcher"
@@ -67,6 +136,9 @@ const (
 	// MPISSHAuthVolumeName is the volume name for Secret with MPI SSH keys.
 	MPISSHAuthVolumeName string = "mpi-ssh-auth"
 
+	// MPISSHAuthMountPath is the default directory to mount Secret with MPI SSH keys.
+	MPISSHAuthMountPath string = "/root/.ssh"
+
 	// MPISSHPrivateKeyFile is the file name for the private key.
 	MPISSHPrivateKeyFile string = "id_rsa"
 
@@ -108,6 +180,40 @@ const (
 
 	// OpenMPIEnvDefaultSlots is the OpenMPI default number of slots env key.
 	OpenMPIEnvDefaultSlots string = "OMPI_MCA_orte_set_default_slots"
+
+	// Values for Intel MPI implementation.
+
+	// IntelMPIEnvHostFileLocation is the Intel MPI default hostfile env key.
+	IntelMPIEnvHostFileLocation string = "I_MPI_HYDRA_HOST_FILE"
+
+	// IntelMPIEnvKeyBootstrapArgs is the env key for the Intel MPI bootstrap (ssh) arguments.
+	IntelMPIEnvKeyBootstrapArgs string = "I_MPI_HYDRA_BOOTSTRAP_EXEC_EXTRA_ARGS"
+
+	// IntelMPIEnvPerHost is the Intel MPI default number of processes per host env key.
+	IntelMPIEnvPerHost string = "I_MPI_PERHOST"
+
+	// Values for MPICH implementation.
+
+	// MPICHEnvHostFileLocation is the MPICH default hostfile env key.
+	MPICHEnvHostFileLocation string = "HYDRA_HOST_FILE"
+
+	// MPICHEnvKeyLaunchArgs is the env key for the MPICH launcher (ssh) arguments.
+	MPICHEnvKeyLaunchArgs string = "HYDRA_LAUNCH_EXTRA_ARGS"
+
+	// MPIEnvDefaultValueSSHArgs is the default env value for the ssh arguments used by mpirun.
+	MPIEnvDefaultValueSSHArgs string = "-o ConnectionAttempts=10"
+
+	// Values for the DeepSpeed launcher.
+
+	// DeepSpeedEnvHostfile is the env name for the hostfile path, which is compatible with the `--hostfile` argument.
+	DeepSpeedEnvHostfile string = "DEEPSPEED_HOSTFILE"
+
+	// DeepSpeedEnvNumGPUs is the env name for the number of processes per node, which is compatible with the `--num_gpus` argument.
+	DeepSpeedEnvNumGPUs string = "DEEPSPEED_NUM_GPUS"
+
+	// DeepSpeedEnvNumNodes is the env name for the number of training nodes, which is compatible with the `--num_nodes` argument.
+	DeepSpeedEnvNumNodes string = "DEEPSPEED_NUM_NODES"
+
 	// Distributed envs for torchrun.
 	// Ref: https://github.com/pytorch/pytorch/blob/3a0d0885171376ed610c8175a19ba40411fc6f3f/torch/distributed/argparse_util.py#L45
 	// TorchEnvNumNodes is the env name for the number of training nodes.
@@ -125,6 +231,18 @@ const (
 	// TorchEnvMasterPort is the env name for the master node port.
 	TorchEnvMasterPort string = "PET_MASTER_PORT"
 
+	// TorchEnvRdzvBackend is the env name for the rendezvous backend.
+	TorchEnvRdzvBackend string = "PET_RDZV_BACKEND"
+
+	// TorchEnvRdzvEndpoint is the env name for the rendezvous endpoint.
+	TorchEnvRdzvEndpoint string = "PET_RDZV_ENDPOINT"
+
+	// TorchEnvMaxRestarts is the env name for the maximum number of worker group restarts.
+	TorchEnvMaxRestarts string = "PET_MAX_RESTARTS"
+
+	// TorchRdzvBackendC10d is the c10d rendezvous backend which assigns the node rank dynamically.
+	TorchRdzvBackendC10d string = "c10d"
+
 	// TorchTuneArgRdzvEndpoint is the arg name for the rendezvous endpoint.
 	TorchTuneArgRdzvEndpoint string = "--rdzv_endpoint"
 
@@ -154,6 +272,51 @@ const (
 
 	// TorchTuneCheckpointerDir is the config item name for the checkpointer directory.
 	TorchTuneCheckpointDir string = "checkpointer.checkpoint_dir"
+
+	// Distributed envs for jax.distributed.initialize().
+	// Ref: https://jax.readthedocs.io/en/latest/_autosummary/jax.distributed.initialize.html
+
+	// JAXEnvCoordinatorAddress is the env name for the address of the process 0 with the port.
+	JAXEnvCoordinatorAddress string = "JAX_COORDINATOR_ADDRESS"
+
+	// JAXEnvNumProcesses is the env name for the number of JAX processes.
+	JAXEnvNumProcesses string = "JAX_NUM_PROCESSES"
+
+	// JAXEnvProcessId is the env name for the ID of the current JAX process.
+	JAXEnvProcessId string = "JAX_PROCESS_ID"
+
+	// Distributed envs for TensorFlow.
+	// Ref: https://www.tensorflow.org/guide/distributed_training#setting_up_the_tf_config_environment_variable
+
+	// TensorFlowEnvConfig is the env name for the TensorFlow cluster spec and the current task.
+	TensorFlowEnvConfig string = "TF_CONFIG"
+
+	// TensorFlowEnvTaskIndex is the env name for the index of the current task, which is referenced by TF_CONFIG.
+	TensorFlowEnvTaskIndex string = "TF_TASK_INDEX"
+
+	// TensorFlowRoleChief is the TensorFlow task type for the chief.
+	TensorFlowRoleChief string = "chief"
+
+	// TensorFlowRoleWorker is the TensorFlow task type for the worker.
+	TensorFlowRoleWorker string = "worker"
+
+	// TensorFlowRolePS is the TensorFlow task type for the parameter server.
+	TensorFlowRolePS string = "ps"
+
+	// Distributed envs for XGBoost.
+	// Ref: https://xgboost.readthedocs.io/en/stable/tutorials/dask.html
+
+	// XGBoostEnvTrackerURI is the env name for the address of the Rabit tracker.
+	XGBoostEnvTrackerURI string = "DMLC_TRACKER_URI"
+
+	// XGBoostEnvTrackerPort is the env name for the port of the Rabit tracker.
+	XGBoostEnvTrackerPort string = "DMLC_TRACKER_PORT"
+
+	// XGBoostEnvNumWorker is the env name for the number of XGBoost workers.
+	XGBoostEnvNumWorker string = "DMLC_NUM_WORKER"
+
+	// XGBoostEnvTaskID is the env name for the ID of the current XGBoost worker.
+	XGBoostEnvTaskID string = "DMLC_TASK_ID"
 )
 
 const (
@@ -172,7 +335,28 @@ var (
 	JobCompletionIndexFieldPath string = fmt.Sprintf("metadata.annotations['%s']", batchv1.JobCompletionIndexAnnotation)
 
 	// TorchRunReservedEnvNames is torchrun reserved env names
-	TorchRunReservedEnvNames = sets.New(TorchEnvNumNodes, TorchEnvNumProcPerNode, TorchEnvNodeRank, TorchEnvMasterAddr, TorchEnvMasterPort)
+	TorchRunReservedEnvNames = sets.New(TorchEnvNumNodes, TorchEnvNumProcPerNode, TorchEnvNodeRank, TorchEnvMasterAddr, TorchEnvMasterPort, TorchEnvRdzvBackend, TorchEnvRdzvEndpoint, TorchEnvMaxRestarts)
+
+	// OpenMPIReservedEnvNames is OpenMPI reserved env names.
+	OpenMPIReservedEnvNames = sets.New(OpenMPIEnvHostFileLocation, OpenMPIEnvKeyRSHArgs, OpenMPIEnvKeepFQDNHostNames, OpenMPIEnvDefaultSlots)
+
+	// IntelMPIReservedEnvNames is Intel MPI reserved env names.
+	IntelMPIReservedEnvNames = sets.New(IntelMPIEnvHostFileLocation, IntelMPIEnvKeyBootstrapArgs, IntelMPIEnvPerHost)
+
+	// MPICHReservedEnvNames is MPICH reserved env names.
+	MPICHReservedEnvNames = sets.New(MPICHEnvHostFileLocation, MPICHEnvKeyLaunchArgs)
+
+	// JAXReservedEnvNames is JAX reserved env names.
+	JAXReservedEnvNames = sets.New(JAXEnvCoordinatorAddress, JAXEnvNumProcesses, JAXEnvProcessId)
+
+	// TensorFlowReservedEnvNames is TensorFlow reserved env names.
+	TensorFlowReservedEnvNames = sets.New(TensorFlowEnvConfig, TensorFlowEnvTaskIndex)
+
+	// XGBoostReservedEnvNames is XGBoost reserved env names.
+	XGBoostReservedEnvNames = sets.New(XGBoostEnvTrackerURI, XGBoostEnvTrackerPort, XGBoostEnvNumWorker, XGBoostEnvTaskID)
+
+	// DeepSpeedReservedEnvNames is DeepSpeed reserved env names.
+	DeepSpeedReservedEnvNames = sets.New(DeepSpeedEnvHostfile, DeepSpeedEnvNumGPUs, DeepSpeedEnvNumNodes)
 
 	// ResourceInUseFinalizer is a finalizer for managed resources which is used by other resources.
 	ResourceInUseFinalizer = fmt.Sprintf("%s/resource-in-use", trainer.GroupVersion.Group)
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -39,6 +40,7 @@ import (
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	jobruntimes "github.com/kubeflow/trainer/v2/pkg/runtime"
 	idxer "github.com/kubeflow/trainer/v2/pkg/runtime/indexer"
 	"github.com/kubeflow/trainer/v2/pkg/util/trainjob"
 )
@@ -47,22 +49,25 @@ type ClusterTrainingRuntimeReconciler struct {
 	log                        logr.Logger
 	client                     client.Client
 	recorder                   record.EventRecorder
+	runtimes                   map[string]jobruntimes.Runtime
 	nonClRuntimeObjectUpdateCh chan event.TypedGenericEvent[iter.Seq[types.NamespacedName]]
 }
 
 var _ reconcile.Reconciler = (*ClusterTrainingRuntimeReconciler)(nil)
 var _ TrainJobWatcher = (*ClusterTrainingRuntimeReconciler)(nil)
 
-func NewClusterTrainingRuntimeReconciler(cli client.Client, recorder record.EventRecorder) *ClusterTrainingRuntimeReconciler {
+func NewClusterTrainingRuntimeReconciler(cli client.Client, recorder record.EventRecorder, runtimes map[string]jobruntimes.Runtime) *ClusterTrainingRuntimeReconciler {
 	return &ClusterTrainingRuntimeReconciler{
 		log:                        ctrl.Log.WithName("clustertrainingruntime-controller"),
 		client:                     cli,
 		recorder:                   recorder,
+		runtimes:                   runtimes,
 		nonClRuntimeObjectUpdateCh: make(chan event.TypedGenericEvent[iter.Seq[types.NamespacedName]], updateChBuffer),
 	}
 }
 
 // +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=clustertrainingruntimes,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=clustertrainingruntimes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=clustertrainingruntimes/finalizers,verbs=get;update;patch
 
 func (r *ClusterTrainingRuntimeReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
@@ -87,6 +92,12 @@ func (r *ClusterTrainingRuntimeReconciler) Reconcile(ctx context.Context, reques
 		ctrlutil.RemoveFinalizer(&clRuntime, constants.ResourceInUseFinalizer)
 		return ctrl.Result{}, r.client.Update(ctx, &clRuntime)
 	}
+
+	originStatus := clRuntime.Status.DeepCopy()
+	setRuntimeStatus(ctx, r.runtimes[runtimeRegistryKey(trainer.ClusterTrainingRuntimeKind)], trainer.ClusterTrainingRuntimeKind, clRuntime.Name, clRuntime.Generation, &clRuntime.Spec, &clRuntime.Status, trainJobs.Items)
+	if !equality.Semantic.DeepEqual(&clRuntime.Status, originStatus) {
+		return ctrl.Result{}, r.client.Status().Update(ctx, &clRuntime)
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -27,7 +27,9 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2/ktesting"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -35,24 +37,37 @@ import (
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	jobruntimes "github.com/kubeflow/trainer/v2/pkg/runtime"
 	idxer "github.com/kubeflow/trainer/v2/pkg/runtime/indexer"
 	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
 )
 
 func TestReconcile_ClusterTrainingRuntimeReconciler(t *testing.T) {
 	errorFailedGetClusterTrainingRuntime := errors.New("TEST: failed to get ClusterTrainingRuntime")
+	earlier := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	later := metav1.NewTime(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))
+	wantValidStatus := trainer.TrainingRuntimeStatus{
+		Conditions: []metav1.Condition{{
+			Type:    trainer.TrainingRuntimeValid,
+			Status:  metav1.ConditionTrue,
+			Reason:  trainer.TrainingRuntimeValidationSucceededReason,
+			Message: constants.TrainingRuntimeValidationSucceededMessage,
+		}},
+	}
 	cases := map[string]struct {
 		trainJobs             trainer.TrainJobList
+		validateRuntimeErrs   field.ErrorList
 		clTrainingRuntime     *trainer.ClusterTrainingRuntime
 		wantClTrainingRuntime *trainer.ClusterTrainingRuntime
 		wantError             error
 	}{
-		"no action when clusterTrainingRuntime with finalizer does not being deleted": {
+		"only status is updated when clusterTrainingRuntime with finalizer does not being deleted": {
 			clTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
 				Finalizers(constants.ResourceInUseFinalizer).
 				Obj(),
 			wantClTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
 				Finalizers(constants.ResourceInUseFinalizer).
+				RuntimeStatus(wantValidStatus).
 				Obj(),
 		},
 		"remove clusterTrainingRuntime due to removed finalizers when runtime without finalizer is deleting": {
@@ -77,7 +92,7 @@ func TestReconcile_ClusterTrainingRuntimeReconciler(t *testing.T) {
 				Finalizers(constants.ResourceInUseFinalizer).
 				Obj(),
 		},
-		"no action when all TrainJobs use another ClusterTrainingRuntime": {
+		"only status is updated when all TrainJobs use another ClusterTrainingRuntime": {
 			clTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
 				Obj(),
 			trainJobs: trainer.TrainJobList{
@@ -88,12 +103,131 @@ func TestReconcile_ClusterTrainingRuntimeReconciler(t *testing.T) {
 				},
 			},
 			wantClTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
+				RuntimeStatus(wantValidStatus).
+				Obj(),
+		},
+		"only status is updated when runtime without finalizer is not used by any TrainJob": {
+			clTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
+				Obj(),
+			wantClTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
+				RuntimeStatus(wantValidStatus).
+				Obj(),
+		},
+		"status is updated with referencing TrainJobs and last used time": {
+			clTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
+				Finalizers(constants.ResourceInUseFinalizer).
+				Obj(),
+			trainJobs: trainer.TrainJobList{
+				Items: []trainer.TrainJob{
+					*utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob1").
+						RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), "runtime").
+						CreationTimestamp(earlier).
+						Obj(),
+					*utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob2").
+						RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), "runtime").
+						CreationTimestamp(later).
+						Obj(),
+				},
+			},
+			wantClTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
+				Finalizers(constants.ResourceInUseFinalizer).
+				RuntimeStatus(trainer.TrainingRuntimeStatus{
+					Conditions:           wantValidStatus.Conditions,
+					ReferencingTrainJobs: 2,
+					LastUsedTime:         &later,
+				}).
+				Obj(),
+		},
+		"status has the false Valid condition when the runtime is invalid": {
+			validateRuntimeErrs: field.ErrorList{
+				field.Required(field.NewPath("spec", "template", "spec", "replicatedJobs"), "must have the launcher replicatedJob"),
+			},
+			clTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
+				Obj(),
+			wantClTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
+				RuntimeStatus(trainer.TrainingRuntimeStatus{
+					Conditions: []metav1.Condition{{
+						Type:    trainer.TrainingRuntimeValid,
+						Status:  metav1.ConditionFalse,
+						Reason:  trainer.TrainingRuntimeValidationFailedReason,
+						Message: "spec.template.spec.replicatedJobs: Required value: must have the launcher replicatedJob",
+					}},
+				}).
 				Obj(),
 		},
-		"no action when runtime without finalizer is not used by any TrainJob": {
+		"status has the capabilities of the runtime": {
 			clTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
+				RuntimeSpec(utiltesting.MakeTrainingRuntimeSpecWrapper(utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").Obj().Spec).
+					WithMLPolicy(utiltesting.MakeMLPolicyWrapper().
+						WithNumNodes(1).
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy(nil, &trainer.TorchElasticPolicy{MinNodes: ptr.To[int32](1), MaxNodes: ptr.To[int32](2)}).
+							Obj(),
+						).
+						Obj(),
+					).
+					PodGroupPolicyCoscheduling(&trainer.CoschedulingPodGroupPolicySource{}).
+					Obj(),
+				).
 				Obj(),
 			wantClTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
+				RuntimeSpec(utiltesting.MakeTrainingRuntimeSpecWrapper(utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").Obj().Spec).
+					WithMLPolicy(utiltesting.MakeMLPolicyWrapper().
+						WithNumNodes(1).
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy(nil, &trainer.TorchElasticPolicy{MinNodes: ptr.To[int32](1), MaxNodes: ptr.To[int32](2)}).
+							Obj(),
+						).
+						Obj(),
+					).
+					PodGroupPolicyCoscheduling(&trainer.CoschedulingPodGroupPolicySource{}).
+					Obj(),
+				).
+				RuntimeStatus(trainer.TrainingRuntimeStatus{
+					Conditions: wantValidStatus.Conditions,
+					Capabilities: []trainer.RuntimeCapability{
+						trainer.RuntimeCapabilityTorch,
+						trainer.RuntimeCapabilityElasticTraining,
+						trainer.RuntimeCapabilityGangScheduling,
+					},
+				}).
+				Obj(),
+		},
+		"status has the Deprecated condition when the runtime is deprecated": {
+			clTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
+				RuntimeSpec(utiltesting.MakeTrainingRuntimeSpecWrapper(utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").Obj().Spec).
+					Deprecation(&trainer.RuntimeRef{Name: "new-runtime", Kind: ptr.To(trainer.ClusterTrainingRuntimeKind)}, nil).
+					Obj(),
+				).
+				Obj(),
+			wantClTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
+				RuntimeSpec(utiltesting.MakeTrainingRuntimeSpecWrapper(utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").Obj().Spec).
+					Deprecation(&trainer.RuntimeRef{Name: "new-runtime", Kind: ptr.To(trainer.ClusterTrainingRuntimeKind)}, nil).
+					Obj(),
+				).
+				RuntimeStatus(trainer.TrainingRuntimeStatus{
+					Conditions: append(wantValidStatus.Conditions, metav1.Condition{
+						Type:    trainer.TrainingRuntimeDeprecated,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainingRuntimeDeprecationRequestedReason,
+						Message: `ClusterTrainingRuntime "runtime" is deprecated, use ClusterTrainingRuntime "new-runtime" instead`,
+					}),
+					Capabilities: wantValidStatus.Capabilities,
+				}).
+				Obj(),
+		},
+		"Deprecated condition is removed when the runtime is no longer deprecated": {
+			clTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
+				RuntimeStatus(trainer.TrainingRuntimeStatus{
+					Conditions: []metav1.Condition{{
+						Type:   trainer.TrainingRuntimeDeprecated,
+						Status: metav1.ConditionTrue,
+						Reason: trainer.TrainingRuntimeDeprecationRequestedReason,
+					}},
+				}).
+				Obj(),
+			wantClTrainingRuntime: utiltesting.MakeClusterTrainingRuntimeWrapper("runtime").
+				RuntimeStatus(wantValidStatus).
 				Obj(),
 		},
 	}
@@ -105,6 +239,7 @@ func TestReconcile_ClusterTrainingRuntimeReconciler(t *testing.T) {
 			t.Cleanup(cancel)
 			cli := utiltesting.NewClientBuilder().
 				WithObjects(tc.clTrainingRuntime).
+				WithStatusSubresource(tc.clTrainingRuntime).
 				WithLists(&tc.trainJobs).
 				WithIndex(&trainer.TrainJob{}, idxer.TrainJobClusterRuntimeRefKey, idxer.IndexTrainJobClusterTrainingRuntime).
 				WithInterceptorFuncs(interceptor.Funcs{
@@ -116,7 +251,9 @@ func TestReconcile_ClusterTrainingRuntimeReconciler(t *testing.T) {
 					},
 				}).
 				Build()
-			r := NewClusterTrainingRuntimeReconciler(cli, nil)
+			r := NewClusterTrainingRuntimeReconciler(cli, nil, map[string]jobruntimes.Runtime{
+				runtimeRegistryKey(trainer.ClusterTrainingRuntimeKind): &fakeRuntime{validateRuntimeErrs: tc.validateRuntimeErrs},
+			})
 			clRuntimeKey := client.ObjectKeyFromObject(tc.clTrainingRuntime)
 			_, gotError := r.Reconcile(ctx, reconcile.Request{NamespacedName: clRuntimeKey})
 			if diff := cmp.Diff(tc.wantError, gotError, cmpopts.EquateErrors()); len(diff) != 0 {
@@ -129,6 +266,7 @@ func TestReconcile_ClusterTrainingRuntimeReconciler(t *testing.T) {
 			}
 			if diff := cmp.Diff(tc.wantClTrainingRuntime, &gotClRuntime,
 				cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion"),
+				cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
 			); len(diff) != 0 {
 				t.Errorf("Unexpected ClusterTrainingRuntime: (-want, +got): \n%s", diff)
 			}
@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
@@ -28,15 +29,17 @@ func SetupControllers(mgr ctrl.Manager, runtimes map[string]runtime.Runtime, opt
 	runtimeRec := NewTrainingRuntimeReconciler(
 		mgr.GetClient(),
 		mgr.GetEventRecorderFor("trainer-trainingruntime-controller"),
+		runtimes,
 	)
-	if err := runtimeRec.SetupWithManager(mgr, options); err != nil {
+	if err := runtimeRec.SetupWithManager(mgr, optionsFor(mgr, options, trainer.TrainingRuntimeKind)); err != nil {
 		return trainer.TrainingRuntimeKind, err
 	}
 	clRuntimeRec := NewClusterTrainingRuntimeReconciler(
 		mgr.GetClient(),
 		mgr.GetEventRecorderFor("trainer-clustertrainingruntime-controller"),
+		runtimes,
 	)
-	if err := clRuntimeRec.SetupWithManager(mgr, options); err != nil {
+	if err := clRuntimeRec.SetupWithManager(mgr, optionsFor(mgr, options, trainer.ClusterTrainingRuntimeKind)); err != nil {
 		return trainer.ClusterTrainingRuntimeKind, err
 	}
 	if err := NewTrainJobReconciler(
@@ -44,8 +47,21 @@ func SetupControllers(mgr ctrl.Manager, runtimes map[string]runtime.Runtime, opt
 		mgr.GetEventRecorderFor("trainer-trainjob-controller"),
 		runtimes,
 		WithWatchers(runtimeRec, clRuntimeRec),
-	).SetupWithManager(mgr, options); err != nil {
+	).SetupWithManager(mgr, optionsFor(mgr, options, trainer.TrainJobKind)); err != nil {
 		return trainer.TrainJobKind, err
 	}
 	return "", nil
 }
+
+// optionsFor returns the controller options with the concurrency configured for the kind
+// in the manager GroupKindConcurrency, unless MaxConcurrentReconciles is already set.
+func optionsFor(mgr ctrl.Manager, options controller.Options, kind string) controller.Options {
+	if options.MaxConcurrentReconciles != 0 {
+		return options
+	}
+	gk := schema.GroupKind{Group: trainer.GroupVersion.Group, Kind: kind}
+	if concurrency, ok := mgr.GetControllerOptions().GroupKindConcurrency[gk.String()]; ok {
+		options.MaxConcurrentReconciles = concurrency
+	}
+	return options
+}
@@ -23,10 +23,14 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,6 +43,7 @@ import (
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	jobruntimes "github.com/kubeflow/trainer/v2/pkg/runtime"
 	idxer "github.com/kubeflow/trainer/v2/pkg/runtime/indexer"
 	"github.com/kubeflow/trainer/v2/pkg/util/trainjob"
 )
@@ -51,22 +56,25 @@ type TrainingRuntimeReconciler struct {
 	log                      logr.Logger
 	client                   client.Client
 	recorder                 record.EventRecorder
+	runtimes                 map[string]jobruntimes.Runtime
 	nonRuntimeObjectUpdateCh chan event.TypedGenericEvent[iter.Seq[types.NamespacedName]]
 }
 
 var _ reconcile.Reconciler = (*TrainingRuntimeReconciler)(nil)
 var _ TrainJobWatcher = (*TrainingRuntimeReconciler)(nil)
 
-func NewTrainingRuntimeReconciler(cli client.Client, recorder record.EventRecorder) *TrainingRuntimeReconciler {
+func NewTrainingRuntimeReconciler(cli client.Client, recorder record.EventRecorder, runtimes map[string]jobruntimes.Runtime) *TrainingRuntimeReconciler {
 	return &TrainingRuntimeReconciler{
 		log:                      ctrl.Log.WithName("trainingruntime-controller"),
 		client:                   cli,
 		recorder:                 recorder,
+		runtimes:                 runtimes,
 		nonRuntimeObjectUpdateCh: make(chan event.TypedGenericEvent[iter.Seq[types.NamespacedName]], updateChBuffer),
 	}
 }
 
 // +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=trainingruntimes,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=trainingruntimes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=trainingruntimes/finalizers,verbs=get;update;patch
 
 func (r *TrainingRuntimeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -91,9 +99,108 @@ func (r *TrainingRuntimeReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		ctrlutil.RemoveFinalizer(&runtime, constants.ResourceInUseFinalizer)
 		return ctrl.Result{}, r.client.Update(ctx, &runtime)
 	}
+
+	originStatus := runtime.Status.DeepCopy()
+	setRuntimeStatus(ctx, r.runtimes[runtimeRegistryKey(trainer.TrainingRuntimeKind)], trainer.TrainingRuntimeKind, runtime.Name, runtime.Generation, &runtime.Spec, &runtime.Status, trainJobs.Items)
+	if !equality.Semantic.DeepEqual(&runtime.Status, originStatus) {
+		return ctrl.Result{}, r.client.Status().Update(ctx, &runtime)
+	}
 	return ctrl.Result{}, nil
 }
 
+func runtimeRegistryKey(kind string) string {
+	return jobruntimes.RuntimeRefToRuntimeRegistryKey(trainer.RuntimeRef{
+		APIGroup: ptr.To(trainer.GroupVersion.Group),
+		Kind:     ptr.To(kind),
+	})
+}
+
+// setRuntimeStatus sets the TrainingRuntime and ClusterTrainingRuntime status from the runtime spec
+// and the TrainJobs referencing the runtime.
+func setRuntimeStatus(
+	ctx context.Context,
+	runtime jobruntimes.Runtime,
+	kind string,
+	name string,
+	generation int64,
+	spec *trainer.TrainingRuntimeSpec,
+	status *trainer.TrainingRuntimeStatus,
+	trainJobs []trainer.TrainJob,
+) {
+	if runtime != nil {
+		validCond := metav1.Condition{
+			Type:               trainer.TrainingRuntimeValid,
+			Status:             metav1.ConditionTrue,
+			Reason:             trainer.TrainingRuntimeValidationSucceededReason,
+			Message:            constants.TrainingRuntimeValidationSucceededMessage,
+			ObservedGeneration: generation,
+		}
+		if _, errs := jobruntimes.ValidateRuntimeSpec(ctx, runtime, spec); len(errs) != 0 {
+			validCond.Status = metav1.ConditionFalse
+			validCond.Reason = trainer.TrainingRuntimeValidationFailedReason
+			validCond.Message = errs.ToAggregate().Error()
+		}
+		meta.SetStatusCondition(&status.Conditions, validCond)
+	}
+	if spec.Deprecation != nil {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               trainer.TrainingRuntimeDeprecated,
+			Status:             metav1.ConditionTrue,
+			Reason:             trainer.TrainingRuntimeDeprecationRequestedReason,
+			Message:            jobruntimes.DeprecationMessage(kind, name, spec.Deprecation),
+			ObservedGeneration: generation,
+		})
+	} else {
+		meta.RemoveStatusCondition(&status.Conditions, trainer.TrainingRuntimeDeprecated)
+	}
+	status.ReferencingTrainJobs = int32(len(trainJobs))
+	status.Capabilities = runtimeCapabilities(spec)
+	for _, trainJob := range trainJobs {
+		if trainJob.CreationTimestamp.IsZero() {
+			continue
+		}
+		if status.LastUsedTime == nil || status.LastUsedTime.Before(&trainJob.CreationTimestamp) {
+			status.LastUsedTime = trainJob.CreationTimestamp.DeepCopy()
+		}
+	}
+}
+
+// runtimeCapabilities returns the capabilities supported by the runtime spec.
+func runtimeCapabilities(spec *trainer.TrainingRuntimeSpec) []trainer.RuntimeCapability {
+	var capabilities []trainer.RuntimeCapability
+	if mlPolicy := spec.MLPolicy; mlPolicy != nil {
+		switch {
+		case mlPolicy.Torch != nil:
+			capabilities = append(capabilities, trainer.RuntimeCapabilityTorch)
+			if mlPolicy.Torch.ElasticPolicy != nil {
+				capabilities = append(capabilities, trainer.RuntimeCapabilityElasticTraining)
+			}
+		case mlPolicy.MPI != nil:
+			capabilities = append(capabilities, trainer.RuntimeCapabilityMPI)
+		case mlPolicy.JAX != nil:
+			capabilities = append(capabilities, trainer.RuntimeCapabilityJAX)
+		case mlPolicy.TensorFlow != nil:
+			capabilities = append(capabilities, trainer.RuntimeCapabilityTensorFlow)
+		case mlPolicy.XGBoost != nil:
+			capabilities = append(capabilities, trainer.RuntimeCapabilityXGBoost)
+		case mlPolicy.DeepSpeed != nil:
+			capabilities = append(capabilities, trainer.RuntimeCapabilityDeepSpeed)
+		}
+	}
+	if spec.PodGroupPolicy != nil {
+		capabilities = append(capabilities, trainer.RuntimeCapabilityGangScheduling)
+	}
+	for _, rJob := range spec.Template.Spec.ReplicatedJobs {
+		switch rJob.Template.Labels[constants.LabelTrainJobAncestor] {
+		case constants.DatasetInitializer:
+			capabilities = append(capabilities, trainer.RuntimeCapabilityDatasetInitializer)
+		case constants.ModelInitializer:
+			capabilities = append(capabilities, trainer.RuntimeCapabilityModelInitializer)
+		}
+	}
+	return capabilities
+}
+
 func (r *TrainingRuntimeReconciler) NotifyTrainJobUpdate(oldJob, newJob *trainer.TrainJob) {
 	var runtimeNSName *types.NamespacedName
 	switch {
@@ -27,7 +27,9 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2/ktesting"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -35,24 +37,41 @@ import (
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	jobruntimes "github.com/kubeflow/trainer/v2/pkg/runtime"
 	idxer "github.com/kubeflow/trainer/v2/pkg/runtime/indexer"
 	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
 )
 
 func TestReconcile_TrainingRuntimeReconciler(t *testing.T) {
 	errorFailedGetTrainingRuntime := errors.New("TEST: failed to get TrainingRuntime")
+	earlier := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	later := metav1.NewTime(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))
+	wantValidStatus := trainer.TrainingRuntimeStatus{
+		Conditions: []metav1.Condition{{
+			Type:    trainer.TrainingRuntimeValid,
+			Status:  metav1.ConditionTrue,
+			Reason:  trainer.TrainingRuntimeValidationSucceededReason,
+			Message: constants.TrainingRuntimeValidationSucceededMessage,
+		}},
+		Capabilities: []trainer.RuntimeCapability{
+			trainer.RuntimeCapabilityDatasetInitializer,
+			trainer.RuntimeCapabilityModelInitializer,
+		},
+	}
 	cases := map[string]struct {
 		trainJobs           trainer.TrainJobList
+		validateRuntimeErrs field.ErrorList
 		trainingRuntime     *trainer.TrainingRuntime
 		wantTrainingRuntime *trainer.TrainingRuntime
 		wantError           error
 	}{
-		"no action when runtime with finalizer does not being deleted": {
+		"only status is updated when runtime with finalizer does not being deleted": {
 			trainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
 				Finalizers(constants.ResourceInUseFinalizer).
 				Obj(),
 			wantTrainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
 				Finalizers(constants.ResourceInUseFinalizer).
+				RuntimeStatus(wantValidStatus).
 				Obj(),
 		},
 		"remove trainingRuntime due to removed finalizers when runtime without finalizer is deleting": {
@@ -77,7 +96,7 @@ func TestReconcile_TrainingRuntimeReconciler(t *testing.T) {
 				Finalizers(constants.ResourceInUseFinalizer).
 				Obj(),
 		},
-		"no action when all TrainJobs use another TrainingRuntime": {
+		"only status is updated when all TrainJobs use another TrainingRuntime": {
 			trainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
 				Obj(),
 			trainJobs: trainer.TrainJobList{
@@ -88,12 +107,159 @@ func TestReconcile_TrainingRuntimeReconciler(t *testing.T) {
 				},
 			},
 			wantTrainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
+				RuntimeStatus(wantValidStatus).
+				Obj(),
+		},
+		"only status is updated when runtime without finalizer is not used by any TrainJob": {
+			trainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
+				Obj(),
+			wantTrainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
+				RuntimeStatus(wantValidStatus).
+				Obj(),
+		},
+		"status is updated with referencing TrainJobs and last used time": {
+			trainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
+				Finalizers(constants.ResourceInUseFinalizer).
+				Obj(),
+			trainJobs: trainer.TrainJobList{
+				Items: []trainer.TrainJob{
+					*utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob1").
+						RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+						CreationTimestamp(earlier).
+						Obj(),
+					*utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob2").
+						RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+						CreationTimestamp(later).
+						Obj(),
+				},
+			},
+			wantTrainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
+				Finalizers(constants.ResourceInUseFinalizer).
+				RuntimeStatus(trainer.TrainingRuntimeStatus{
+					Conditions:           wantValidStatus.Conditions,
+					ReferencingTrainJobs: 2,
+					Capabilities:         wantValidStatus.Capabilities,
+					LastUsedTime:         &later,
+				}).
+				Obj(),
+		},
+		"status has the false Valid condition when the runtime is invalid": {
+			validateRuntimeErrs: field.ErrorList{
+				field.Required(field.NewPath("spec", "template", "spec", "replicatedJobs"), "must have the launcher replicatedJob"),
+			},
+			trainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
+				Obj(),
+			wantTrainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
+				RuntimeStatus(trainer.TrainingRuntimeStatus{
+					Conditions: []metav1.Condition{{
+						Type:    trainer.TrainingRuntimeValid,
+						Status:  metav1.ConditionFalse,
+						Reason:  trainer.TrainingRuntimeValidationFailedReason,
+						Message: "spec.template.spec.replicatedJobs: Required value: must have the launcher replicatedJob",
+					}},
+					Capabilities: wantValidStatus.Capabilities,
+				}).
+				Obj(),
+		},
+		"status has the false Valid condition when the replicatedJobs are invalid": {
+			trainingRuntime: func() *trainer.TrainingRuntime {
+				runtime := utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").Obj()
+				runtime.Spec.Template.Spec.ReplicatedJobs[0].Name = constants.Launcher
+				runtime.Spec.Template.Spec.ReplicatedJobs[0].Replicas = 2
+				return runtime
+			}(),
+			wantTrainingRuntime: func() *trainer.TrainingRuntime {
+				runtime := utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
+					RuntimeStatus(trainer.TrainingRuntimeStatus{
+						Conditions: []metav1.Condition{{
+							Type:    trainer.TrainingRuntimeValid,
+							Status:  metav1.ConditionFalse,
+							Reason:  trainer.TrainingRuntimeValidationFailedReason,
+							Message: "spec.template.spec.replicatedJobs[0].replicas: Invalid value: 2: always must be 1",
+						}},
+						Capabilities: wantValidStatus.Capabilities,
+					}).
+					Obj()
+				runtime.Spec.Template.Spec.ReplicatedJobs[0].Name = constants.Launcher
+				runtime.Spec.Template.Spec.ReplicatedJobs[0].Replicas = 2
+				return runtime
+			}(),
+		},
+		"status has the capabilities of the runtime": {
+			trainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
+				RuntimeSpec(utiltesting.MakeTrainingRuntimeSpecWrapper(utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").Obj().Spec).
+					WithMLPolicy(utiltesting.MakeMLPolicyWrapper().
+						WithNumNodes(1).
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy(nil, &trainer.TorchElasticPolicy{MinNodes: ptr.To[int32](1), MaxNodes: ptr.To[int32](2)}).
+							Obj(),
+						).
+						Obj(),
+					).
+					PodGroupPolicyCoscheduling(&trainer.CoschedulingPodGroupPolicySource{}).
+					Obj(),
+				).
+				Obj(),
+			wantTrainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
+				RuntimeSpec(utiltesting.MakeTrainingRuntimeSpecWrapper(utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").Obj().Spec).
+					WithMLPolicy(utiltesting.MakeMLPolicyWrapper().
+						WithNumNodes(1).
+						WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+							TorchPolicy(nil, &trainer.TorchElasticPolicy{MinNodes: ptr.To[int32](1), MaxNodes: ptr.To[int32](2)}).
+							Obj(),
+						).
+						Obj(),
+					).
+					PodGroupPolicyCoscheduling(&trainer.CoschedulingPodGroupPolicySource{}).
+					Obj(),
+				).
+				RuntimeStatus(trainer.TrainingRuntimeStatus{
+					Conditions: wantValidStatus.Conditions,
+					Capabilities: []trainer.RuntimeCapability{
+						trainer.RuntimeCapabilityTorch,
+						trainer.RuntimeCapabilityElasticTraining,
+						trainer.RuntimeCapabilityGangScheduling,
+						trainer.RuntimeCapabilityDatasetInitializer,
+						trainer.RuntimeCapabilityModelInitializer,
+					},
+				}).
+				Obj(),
+		},
+		"status has the Deprecated condition when the runtime is deprecated": {
+			trainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
+				RuntimeSpec(utiltesting.MakeTrainingRuntimeSpecWrapper(utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").Obj().Spec).
+					Deprecation(&trainer.RuntimeRef{Name: "new-runtime", Kind: ptr.To(trainer.TrainingRuntimeKind)}, nil).
+					Obj(),
+				).
+				Obj(),
+			wantTrainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
+				RuntimeSpec(utiltesting.MakeTrainingRuntimeSpecWrapper(utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").Obj().Spec).
+					Deprecation(&trainer.RuntimeRef{Name: "new-runtime", Kind: ptr.To(trainer.TrainingRuntimeKind)}, nil).
+					Obj(),
+				).
+				RuntimeStatus(trainer.TrainingRuntimeStatus{
+					Conditions: append(wantValidStatus.Conditions, metav1.Condition{
+						Type:    trainer.TrainingRuntimeDeprecated,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainingRuntimeDeprecationRequestedReason,
+						Message: `TrainingRuntime "runtime" is deprecated, use TrainingRuntime "new-runtime" instead`,
+					}),
+					Capabilities: wantValidStatus.Capabilities,
+				}).
 				Obj(),
 		},
-		"no action when runtime without finalizer is not used by any TrainJob": {
+		"Deprecated condition is removed when the runtime is no longer deprecated": {
 			trainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
+				RuntimeStatus(trainer.TrainingRuntimeStatus{
+					Conditions: []metav1.Condition{{
+						Type:   trainer.TrainingRuntimeDeprecated,
+						Status: metav1.ConditionTrue,
+						Reason: trainer.TrainingRuntimeDeprecationRequestedReason,
+					}},
+				}).
 				Obj(),
 			wantTrainingRuntime: utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").
+				RuntimeStatus(wantValidStatus).
 				Obj(),
 		},
 	}
@@ -105,6 +271,7 @@ func TestReconcile_TrainingRuntimeReconciler(t *testing.T) {
 			t.Cleanup(cancel)
 			cli := utiltesting.NewClientBuilder().
 				WithObjects(tc.trainingRuntime).
+				WithStatusSubresource(tc.trainingRuntime).
 				WithIndex(&trainer.TrainJob{}, idxer.TrainJobRuntimeRefKey, idxer.IndexTrainJobTrainingRuntime).
 				WithLists(&tc.trainJobs).
 				WithInterceptorFuncs(interceptor.Funcs{
@@ -116,7 +283,9 @@ func TestReconcile_TrainingRuntimeReconciler(t *testing.T) {
 					},
 				}).
 				Build()
-			r := NewTrainingRuntimeReconciler(cli, nil)
+			r := NewTrainingRuntimeReconciler(cli, nil, map[string]jobruntimes.Runtime{
+				runtimeRegistryKey(trainer.TrainingRuntimeKind): &fakeRuntime{validateRuntimeErrs: tc.validateRuntimeErrs},
+			})
 			runtimeKey := client.ObjectKeyFromObject(tc.trainingRuntime)
 			_, gotError := r.Reconcile(ctx, reconcile.Request{NamespacedName: runtimeKey})
 			if diff := cmp.Diff(tc.wantError, gotError, cmpopts.EquateErrors()); len(diff) != 0 {
@@ -129,6 +298,7 @@ func TestReconcile_TrainingRuntimeReconciler(t *testing.T) {
 			}
 			if diff := cmp.Diff(tc.wantTrainingRuntime, &gotRuntime,
 				cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion"),
+				cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
 			); len(diff) != 0 {
 				t.Errorf("Unexpected TrainingRuntime: (-want, +got): \n%s", diff)
 			}
@@ -22,8 +22,11 @@ import (
 	"fmt"
 	"iter"
 	"slices"
+	"strconv"
+	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -33,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -47,7 +51,11 @@ import (
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/metrics"
 	jobruntimes "github.com/kubeflow/trainer/v2/pkg/runtime"
+	idxer "github.com/kubeflow/trainer/v2/pkg/runtime/indexer"
+	"github.com/kubeflow/trainer/v2/pkg/tracing"
+	"github.com/kubeflow/trainer/v2/pkg/util/trainjob"
 )
 
 type TrainJobWatcher interface {
@@ -60,27 +68,39 @@ type TrainJobReconciler struct {
 	recorder record.EventRecorder
 	runtimes map[string]jobruntimes.Runtime
 	watchers iter.Seq[TrainJobWatcher]
+	clock    clock.Clock
 }
 
 type TrainJobReconcilerOptions struct {
 	Watchers iter.Seq[TrainJobWatcher]
+	Clock    clock.Clock
 }
 
 type TrainJobReconcilerOption func(*TrainJobReconcilerOptions)
 
+var defaultTrainJobReconcilerOptions = TrainJobReconcilerOptions{
+	Clock: clock.RealClock{},
+}
+
 func WithWatchers(watchers ...TrainJobWatcher) TrainJobReconcilerOption {
 	return func(o *TrainJobReconcilerOptions) {
 		o.Watchers = slices.Values(watchers)
 	}
 }
 
+func WithClock(c clock.Clock) TrainJobReconcilerOption {
+	return func(o *TrainJobReconcilerOptions) {
+		o.Clock = c
+	}
+}
+
 var _ reconcile.Reconciler = (*TrainJobReconciler)(nil)
 var _ predicate.TypedPredicate[*trainer.TrainJob] = (*TrainJobReconciler)(nil)
 
 func NewTrainJobReconciler(client client.Client, recorder record.EventRecorder, runtimes map[string]jobruntimes.Runtime, opts ...TrainJobReconcilerOption) *TrainJobReconciler {
-	options := &TrainJobReconcilerOptions{}
+	options := defaultTrainJobReconcilerOptions
 	for _, opt := range opts {
-		opt(options)
+		opt(&options)
 	}
 	return &TrainJobReconciler{
 		log:      ctrl.Log.WithName("trainjob-controller"),
@@ -88,35 +108,63 @@ func NewTrainJobReconciler(client client.Client, recorder record.EventRecorder,
 		recorder: recorder,
 		runtimes: runtimes,
 		watchers: options.Watchers,
+		clock:    options.Clock,
 	}
 }
 
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update;patch
-// +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=trainjobs,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=trainjobs,verbs=get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=trainjobs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=trainjobs/finalizers,verbs=get;update;patch
 
-func (r *TrainJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *TrainJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Reconcile TrainJob", trace.WithAttributes(
+		tracing.TrainJobNamespaceKey.String(req.Namespace),
+		tracing.TrainJobNameKey.String(req.Name),
+	))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	var trainJob trainer.TrainJob
 	if err := r.client.Get(ctx, req.NamespacedName, &trainJob); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	span.SetAttributes(tracing.TrainJobAttributes(&trainJob)...)
 	log := ctrl.LoggerFrom(ctx).WithValues("trainJob", klog.KObj(&trainJob))
 	ctx = ctrl.LoggerInto(ctx, log)
 	log.V(2).Info("Reconciling TrainJob")
-	if isTrainJobFinished(&trainJob) {
-		log.V(5).Info("TrainJob has already been finished")
+	if !trainjob.IsManagedByTrainJobController(&trainJob) {
+		log.V(5).Info("Skipping TrainJob managed by an external controller", "managedBy", ptr.Deref(trainJob.Spec.ManagedBy, ""))
 		return ctrl.Result{}, nil
 	}
+	if trainjob.IsFinished(&trainJob) {
+		log.V(5).Info("TrainJob has already been finished")
+		return r.reconcileTTLAfterFinished(ctx, &trainJob)
+	}
+
+	_, reResolveRuntime := trainJob.Annotations[constants.AnnotationReResolveRuntime]
 
-	var err error
 	// Keep track of the origin TrainJob status
 	originStatus := trainJob.Status.DeepCopy()
 
+	// The runtime snapshot is dropped so that the runtime takes the new snapshot from the latest runtime.
+	if reResolveRuntime && trainJob.Status.RuntimeSnapshot != nil {
+		log.V(2).Info("Re-resolving TrainJob to the latest runtime", "runtimeSnapshot", trainJob.Status.RuntimeSnapshot.Name)
+		r.recorder.Event(&trainJob, corev1.EventTypeNormal, "RuntimeReResolved", "TrainJob is re-resolved to the latest runtime")
+		trainJob.Status.RuntimeSnapshot = nil
+	}
+
 	// Let's clear the failed condition that could have been set previously.
 	// An external change to the TrainJob spec may transition it out of the Failed state.
 	removeFailedCondition(&trainJob)
 
+	// The TrainJob resources are terminated by the runtime once the TrainJob is failed with DeadlineExceeded.
+	if timeLeft := timeLeftUntilActiveDeadline(&trainJob, r.clock.Now()); timeLeft != nil && *timeLeft <= 0 {
+		log.V(2).Info("TrainJob has exceeded the activeDeadlineSeconds", "activeDeadlineSeconds", *trainJob.Spec.ActiveDeadlineSeconds)
+		r.recorder.Event(&trainJob, corev1.EventTypeWarning, trainer.TrainJobDeadlineExceededReason, constants.TrainJobDeadlineExceededMessage)
+		setFailedCondition(&trainJob, constants.TrainJobDeadlineExceededMessage, trainer.TrainJobDeadlineExceededReason)
+	}
+
 	runtimeRefGK := jobruntimes.RuntimeRefToRuntimeRegistryKey(trainJob.Spec.RuntimeRef)
 	runtime, ok := r.runtimes[runtimeRefGK]
 	if !ok {
@@ -125,15 +173,16 @@ func (r *TrainJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	} else {
 		err = r.reconcileObjects(ctx, runtime, &trainJob)
 		if err != nil {
-			// TODO (astefanutti): the error should be surfaced in the TrainJob status to indicate
-			//  the creation of the runtime resources failed and the TrainJob is backed off until
-			//  the next retry attempt.
-			// The event message is truncated to stay within the maximum length limit (1024 chars).
+			// The event and condition message is truncated to stay within the maximum length limit (1024 chars).
 			message := fmt.Sprintf("TrainJob resources reconciliation failed: %.950v", err.Error())
 			if len(err.Error()) > 950 {
 				message = fmt.Sprintf("%s ...", message)
 			}
 			r.recorder.Event(&trainJob, corev1.EventTypeWarning, "TrainJobResourcesCreationFailed", message)
+			// The TrainJob is backed off until the next retry attempt.
+			setResourcesCreationFailedCondition(&trainJob, message, metav1.NewTime(r.clock.Now()))
+		} else {
+			setResourcesCreatedCondition(&trainJob)
 		}
 	}
 
@@ -141,11 +190,71 @@ func (r *TrainJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	if terminalCondErr := setTerminalCondition(ctx, runtime, &trainJob); terminalCondErr != nil {
 		err = errors.Join(err, terminalCondErr)
 	}
+	if runningCondErr := setRunningCondition(ctx, runtime, &trainJob); runningCondErr != nil {
+		err = errors.Join(err, runningCondErr)
+	}
+	if jobsStatusErr := setJobsStatus(ctx, runtime, &trainJob); jobsStatusErr != nil {
+		err = errors.Join(err, jobsStatusErr)
+	}
+	setStartAndCompletionTime(&trainJob, metav1.NewTime(r.clock.Now()))
 
 	if !equality.Semantic.DeepEqual(&trainJob.Status, originStatus) {
-		return ctrl.Result{}, errors.Join(err, r.client.Status().Update(ctx, &trainJob))
+		if updateErr := r.client.Status().Update(ctx, &trainJob); updateErr != nil {
+			return ctrl.Result{}, errors.Join(err, updateErr)
+		}
+		r.reportMetrics(originStatus, &trainJob)
+	}
+	// The annotation is removed only after the dropped runtime snapshot is persisted,
+	// so that the TrainJob is re-resolved again when the status update fails.
+	if reResolveRuntime {
+		if patchErr := r.removeReResolveRuntimeAnnotation(ctx, &trainJob); patchErr != nil {
+			return ctrl.Result{}, errors.Join(err, patchErr)
+		}
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	// The status-only updates don't trigger the reconciliation,
+	// so the TrainJob which has just finished is handled right away.
+	// The history limits are enforced only once when the TrainJob finishes,
+	// not on every reconciliation of the finished TrainJob.
+	if trainjob.IsFinished(&trainJob) {
+		if err = r.cleanupFinishedTrainJobs(ctx, &trainJob); err != nil {
+			return ctrl.Result{}, err
+		}
+		return r.reconcileTTLAfterFinished(ctx, &trainJob)
+	}
+	// The TrainJob is requeued to be failed once the activeDeadlineSeconds is reached.
+	if timeLeft := timeLeftUntilActiveDeadline(&trainJob, r.clock.Now()); timeLeft != nil {
+		return ctrl.Result{RequeueAfter: max(*timeLeft, 0)}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// removeReResolveRuntimeAnnotation removes the annotation to re-resolve the runtime
+// so that the TrainJob is re-resolved to the latest runtime only once.
+func (r *TrainJobReconciler) removeReResolveRuntimeAnnotation(ctx context.Context, trainJob *trainer.TrainJob) error {
+	patch := client.MergeFrom(trainJob.DeepCopy())
+	delete(trainJob.Annotations, constants.AnnotationReResolveRuntime)
+	return r.client.Patch(ctx, trainJob, patch)
+}
+
+// reportMetrics reports the TrainJob lifecycle metrics based on the status transitions
+// which have been persisted in the TrainJob.
+func (r *TrainJobReconciler) reportMetrics(originStatus *trainer.TrainJobStatus, trainJob *trainer.TrainJob) {
+	now := r.clock.Now()
+	if !meta.IsStatusConditionTrue(originStatus.Conditions, trainer.TrainJobRunning) &&
+		meta.IsStatusConditionTrue(trainJob.Status.Conditions, trainer.TrainJobRunning) {
+		metrics.TrainJobRunning(trainJob, now)
+	}
+	for _, result := range []string{trainer.TrainJobComplete, trainer.TrainJobFailed} {
+		if !meta.IsStatusConditionTrue(originStatus.Conditions, result) &&
+			meta.IsStatusConditionTrue(trainJob.Status.Conditions, result) {
+			metrics.TrainJobFinished(trainJob, result, now)
+			metrics.DecActiveTrainJobs(trainJob)
+			break
+		}
 	}
-	return ctrl.Result{}, err
 }
 
 func (r *TrainJobReconciler) reconcileObjects(ctx context.Context, runtime jobruntimes.Runtime, trainJob *trainer.TrainJob) error {
@@ -155,6 +264,7 @@ func (r *TrainJobReconciler) reconcileObjects(ctx context.Context, runtime jobru
 	if err != nil {
 		return err
 	}
+	trace.SpanFromContext(ctx).SetAttributes(tracing.ObjectsCountKey.Int(len(objects)))
 	for _, object := range objects {
 		// TODO (astefanutti): Remove conversion to unstructured when the runtime.ApplyConfiguration
 		//  interface becomes available and first-class SSA method is added to the controller-runtime
@@ -192,19 +302,42 @@ func (r *TrainJobReconciler) reconcileObjects(ctx context.Context, runtime jobru
 func (r *TrainJobReconciler) Create(e event.TypedCreateEvent[*trainer.TrainJob]) bool {
 	r.log.WithValues("trainJob", klog.KObj(e.Object)).Info("TrainJob create event")
 	defer r.notifyWatchers(nil, e.Object)
+	if trainjob.IsManagedByTrainJobController(e.Object) && !trainjob.IsFinished(e.Object) {
+		metrics.IncActiveTrainJobs(e.Object)
+		// The TrainJob without any conditions has not been reconciled yet, so it is newly created
+		// rather than listed again after the controller restart.
+		if len(e.Object.Status.Conditions) == 0 {
+			metrics.TrainJobCreated(e.Object)
+		}
+	}
 	return true
 }
 
 func (r *TrainJobReconciler) Delete(e event.TypedDeleteEvent[*trainer.TrainJob]) bool {
 	r.log.WithValues("trainJob", klog.KObj(e.Object)).Info("TrainJob delete event")
 	defer r.notifyWatchers(e.Object, nil)
+	if trainjob.IsManagedByTrainJobController(e.Object) && !trainjob.IsFinished(e.Object) {
+		metrics.DecActiveTrainJobs(e.Object)
+	}
+	metrics.ClearTrainJobMetrics(e.Object.Namespace, e.Object.Name)
 	return true
 }
 
 func (r *TrainJobReconciler) Update(e event.TypedUpdateEvent[*trainer.TrainJob]) bool {
 	r.log.WithValues("trainJob", klog.KObj(e.ObjectNew)).Info("TrainJob update event")
 	defer r.notifyWatchers(e.ObjectOld, e.ObjectNew)
-	return true
+	// The TrainJob status is updated only by this controller. Reconciling the status-only updates would
+	// bypass the workqueue backoff after the failed resources creation is recorded in the status.
+	return !isStatusOnlyUpdate(e.ObjectOld, e.ObjectNew)
+}
+
+// isStatusOnlyUpdate returns true when the update doesn't change the TrainJob spec and metadata.
+func isStatusOnlyUpdate(oldJob, newJob *trainer.TrainJob) bool {
+	return oldJob.Generation == newJob.Generation &&
+		equality.Semantic.DeepEqual(oldJob.Labels, newJob.Labels) &&
+		equality.Semantic.DeepEqual(oldJob.Annotations, newJob.Annotations) &&
+		equality.Semantic.DeepEqual(oldJob.Finalizers, newJob.Finalizers) &&
+		equality.Semantic.DeepEqual(oldJob.DeletionTimestamp, newJob.DeletionTimestamp)
 }
 
 func (r *TrainJobReconciler) Generic(e event.TypedGenericEvent[*trainer.TrainJob]) bool {
@@ -251,6 +384,30 @@ func setFailedCondition(trainJob *trainer.TrainJob, message, reason string) {
 	meta.SetStatusCondition(&trainJob.Status.Conditions, newCond)
 }
 
+func setResourcesCreationFailedCondition(trainJob *trainer.TrainJob, message string, now metav1.Time) {
+	newCond := metav1.Condition{
+		Type:    trainer.TrainJobCreated,
+		Status:  metav1.ConditionFalse,
+		Message: message,
+		Reason:  trainer.TrainJobResourcesCreationFailedReason,
+	}
+	meta.SetStatusCondition(&trainJob.Status.Conditions, newCond)
+	trainJob.Status.ResourcesCreationRetries = ptr.To(ptr.Deref(trainJob.Status.ResourcesCreationRetries, 0) + 1)
+	trainJob.Status.LastResourcesCreationAttemptTime = &now
+}
+
+func setResourcesCreatedCondition(trainJob *trainer.TrainJob) {
+	newCond := metav1.Condition{
+		Type:    trainer.TrainJobCreated,
+		Status:  metav1.ConditionTrue,
+		Message: constants.TrainJobResourcesCreatedMessage,
+		Reason:  trainer.TrainJobResourcesCreatedReason,
+	}
+	meta.SetStatusCondition(&trainJob.Status.Conditions, newCond)
+	trainJob.Status.ResourcesCreationRetries = nil
+	trainJob.Status.LastResourcesCreationAttemptTime = nil
+}
+
 func removeFailedCondition(trainJob *trainer.TrainJob) {
 	meta.RemoveStatusCondition(&trainJob.Status.Conditions, trainer.TrainJobFailed)
 }
@@ -266,9 +423,178 @@ func setTerminalCondition(ctx context.Context, runtime jobruntimes.Runtime, trai
 	return nil
 }
 
-func isTrainJobFinished(trainJob *trainer.TrainJob) bool {
-	return meta.IsStatusConditionTrue(trainJob.Status.Conditions, trainer.TrainJobComplete) ||
-		meta.IsStatusConditionTrue(trainJob.Status.Conditions, trainer.TrainJobFailed)
+func setRunningCondition(ctx context.Context, runtime jobruntimes.Runtime, trainJob *trainer.TrainJob) error {
+	runningCond, err := runtime.RunningCondition(ctx, trainJob)
+	if err != nil {
+		return err
+	}
+	if runningCond != nil {
+		meta.SetStatusCondition(&trainJob.Status.Conditions, *runningCond)
+	}
+	return nil
+}
+
+func setStartAndCompletionTime(trainJob *trainer.TrainJob, now metav1.Time) {
+	switch {
+	case trainjob.IsFinished(trainJob):
+		if trainJob.Status.CompletionTime == nil {
+			trainJob.Status.CompletionTime = &now
+		}
+	case ptr.Deref(trainJob.Spec.Suspend, false):
+		// The start time is reset when the TrainJob is suspended, and the time the TrainJob was active
+		// until then is accumulated so that the activeDeadlineSeconds isn't restarted after the TrainJob is resumed.
+		if trainJob.Status.StartTime != nil {
+			activeSeconds := int64(now.Sub(trainJob.Status.StartTime.Time) / time.Second)
+			trainJob.Status.ActiveSeconds = ptr.To(ptr.Deref(trainJob.Status.ActiveSeconds, 0) + max(activeSeconds, 0))
+			trainJob.Status.StartTime = nil
+		}
+	case meta.IsStatusConditionTrue(trainJob.Status.Conditions, trainer.TrainJobCreated):
+		if trainJob.Status.StartTime == nil {
+			trainJob.Status.StartTime = &now
+		}
+	}
+}
+
+func setJobsStatus(ctx context.Context, runtime jobruntimes.Runtime, trainJob *trainer.TrainJob) error {
+	jobsStatus, restarts, err := runtime.JobsStatus(ctx, trainJob)
+	if err != nil {
+		return err
+	}
+	trainJob.Status.JobsStatus = jobsStatus
+	if restarts != nil {
+		trainJob.Status.Restarts = restarts
+	}
+	return nil
+}
+
+// reconcileTTLAfterFinished deletes the finished TrainJob
+// once its TTL after finished has expired. Otherwise, the TrainJob is requeued until the TTL expires.
+func (r *TrainJobReconciler) reconcileTTLAfterFinished(ctx context.Context, trainJob *trainer.TrainJob) (ctrl.Result, error) {
+	remaining := timeLeftAfterFinished(trainJob, r.clock.Now())
+	if remaining == nil {
+		return ctrl.Result{}, nil
+	}
+	if *remaining > 0 {
+		return ctrl.Result{RequeueAfter: *remaining}, nil
+	}
+	ctrl.LoggerFrom(ctx).V(2).Info("Deleting TrainJob since its TTL after finished has expired")
+	if err := r.deleteTrainJob(ctx, trainJob); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.recorder.Eventf(trainJob, corev1.EventTypeNormal, "TTLExpired",
+		"TrainJob is deleted since %d seconds have elapsed after it finished", *trainJob.Spec.TTLSecondsAfterFinished)
+	return ctrl.Result{}, nil
+}
+
+// cleanupFinishedTrainJobs deletes the oldest finished TrainJobs with the same runtime as the given TrainJob
+// which exceed the history limits annotated on the Namespace.
+func (r *TrainJobReconciler) cleanupFinishedTrainJobs(ctx context.Context, trainJob *trainer.TrainJob) error {
+	log := ctrl.LoggerFrom(ctx)
+	var ns corev1.Namespace
+	if err := r.client.Get(ctx, client.ObjectKey{Name: trainJob.Namespace}, &ns); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	limits := make(map[string]int, 2)
+	for result, annotation := range map[string]string{
+		trainer.TrainJobComplete: constants.AnnotationSuccessfulTrainJobsHistoryLimit,
+		trainer.TrainJobFailed:   constants.AnnotationFailedTrainJobsHistoryLimit,
+	} {
+		value, ok := ns.Annotations[annotation]
+		if !ok {
+			continue
+		}
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			log.V(2).Info("Ignoring the invalid history limit of the Namespace", "annotation", annotation, "value", value)
+			continue
+		}
+		limits[result] = limit
+	}
+	if len(limits) == 0 {
+		return nil
+	}
+
+	var runtimeRefKey string
+	switch {
+	case trainjob.RuntimeRefIsTrainingRuntime(trainJob.Spec.RuntimeRef):
+		runtimeRefKey = idxer.TrainJobRuntimeRefKey
+	case trainjob.RuntimeRefIsClusterTrainingRuntime(trainJob.Spec.RuntimeRef):
+		runtimeRefKey = idxer.TrainJobClusterRuntimeRefKey
+	default:
+		return nil
+	}
+	// Only the TrainJobs with the same runtime are listed through the field index.
+	var trainJobs trainer.TrainJobList
+	if err := r.client.List(ctx, &trainJobs, client.InNamespace(trainJob.Namespace),
+		client.MatchingFields{runtimeRefKey: trainJob.Spec.RuntimeRef.Name}); err != nil {
+		return err
+	}
+	finished := make(map[string][]*trainer.TrainJob, len(limits))
+	for i := range trainJobs.Items {
+		tj := &trainJobs.Items[i]
+		if tj.DeletionTimestamp != nil || !trainjob.IsManagedByTrainJobController(tj) {
+			continue
+		}
+		for result := range limits {
+			if meta.IsStatusConditionTrue(tj.Status.Conditions, result) {
+				finished[result] = append(finished[result], tj)
+			}
+		}
+	}
+	for result, limit := range limits {
+		if len(finished[result]) <= limit {
+			continue
+		}
+		// The TrainJobs are sorted from the most recently finished to the oldest one.
+		slices.SortFunc(finished[result], func(a, b *trainer.TrainJob) int {
+			return completionTime(b).Compare(completionTime(a))
+		})
+		for _, tj := range finished[result][limit:] {
+			log.V(2).Info("Deleting finished TrainJob exceeding the Namespace history limit", "deletedTrainJob", klog.KObj(tj), "result", result, "limit", limit)
+			if err := r.deleteTrainJob(ctx, tj); err != nil {
+				return err
+			}
+			r.recorder.Eventf(tj, corev1.EventTypeNormal, "HistoryLimitExceeded",
+				"TrainJob is deleted since the number of %s TrainJobs exceeds the Namespace history limit %d", result, limit)
+		}
+	}
+	return nil
+}
+
+func (r *TrainJobReconciler) deleteTrainJob(ctx context.Context, trainJob *trainer.TrainJob) error {
+	// The dependent JobSet is garbage collected in the background through its owner reference.
+	return client.IgnoreNotFound(r.client.Delete(ctx, trainJob, client.PropagationPolicy(metav1.DeletePropagationBackground)))
+}
+
+// timeLeftAfterFinished returns the remaining time until the TTL after finished expires.
+// It returns nil when the TrainJob doesn't have the TTL or the completionTime.
+func timeLeftAfterFinished(trainJob *trainer.TrainJob, now time.Time) *time.Duration {
+	if trainJob.Spec.TTLSecondsAfterFinished == nil || trainJob.Status.CompletionTime == nil {
+		return nil
+	}
+	expireAt := trainJob.Status.CompletionTime.Add(time.Duration(*trainJob.Spec.TTLSecondsAfterFinished) * time.Second)
+	return ptr.To(expireAt.Sub(now))
+}
+
+// timeLeftUntilActiveDeadline returns the remaining time until the TrainJob reaches the activeDeadlineSeconds.
+// The time the TrainJob was active before its latest suspension is subtracted from the activeDeadlineSeconds.
+// It returns nil when the TrainJob doesn't have the activeDeadlineSeconds or the startTime,
+// e.g. while the TrainJob is suspended.
+func timeLeftUntilActiveDeadline(trainJob *trainer.TrainJob, now time.Time) *time.Duration {
+	if trainJob.Spec.ActiveDeadlineSeconds == nil || trainJob.Status.StartTime == nil || ptr.Deref(trainJob.Spec.Suspend, false) {
+		return nil
+	}
+	remainingSeconds := *trainJob.Spec.ActiveDeadlineSeconds - ptr.Deref(trainJob.Status.ActiveSeconds, 0)
+	deadline := trainJob.Status.StartTime.Add(time.Duration(remainingSeconds) * time.Second)
+	return ptr.To(deadline.Sub(now))
+}
+
+// completionTime returns the completionTime of the TrainJob, or the creationTimestamp if it is not set yet.
+func completionTime(trainJob *trainer.TrainJob) time.Time {
+	if trainJob.Status.CompletionTime != nil {
+		return trainJob.Status.CompletionTime.Time
+	}
+	return trainJob.CreationTimestamp.Time
 }
 
 func (r *TrainJobReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
@@ -0,0 +1,728 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2/ktesting"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/metrics"
+	jobruntimes "github.com/kubeflow/trainer/v2/pkg/runtime"
+	idxer "github.com/kubeflow/trainer/v2/pkg/runtime/indexer"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+type fakeRuntime struct {
+	newObjectsErr       error
+	terminalCondition   *metav1.Condition
+	runningCondition    *metav1.Condition
+	runtimeSnapshot     *trainer.RuntimeSnapshot
+	validateRuntimeErrs field.ErrorList
+}
+
+var _ jobruntimes.Runtime = (*fakeRuntime)(nil)
+
+func (f *fakeRuntime) NewObjects(_ context.Context, trainJob *trainer.TrainJob) ([]any, error) {
+	if trainJob.Status.RuntimeSnapshot == nil {
+		trainJob.Status.RuntimeSnapshot = f.runtimeSnapshot
+	}
+	return nil, f.newObjectsErr
+}
+
+func (f *fakeRuntime) TerminalCondition(context.Context, *trainer.TrainJob) (*metav1.Condition, error) {
+	return f.terminalCondition, nil
+}
+
+func (f *fakeRuntime) RunningCondition(context.Context, *trainer.TrainJob) (*metav1.Condition, error) {
+	return f.runningCondition, nil
+}
+
+func (f *fakeRuntime) JobsStatus(context.Context, *trainer.TrainJob) ([]trainer.JobStatus, *int32, error) {
+	return nil, nil, nil
+}
+
+func (f *fakeRuntime) DefaultObjects(context.Context, *trainer.TrainJob) error {
+	return nil
+}
+
+func (f *fakeRuntime) EventHandlerRegistrars() []jobruntimes.ReconcilerBuilder {
+	return nil
+}
+
+func (f *fakeRuntime) ValidateObjects(context.Context, *trainer.TrainJob, *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
+	return nil, nil
+}
+
+func (f *fakeRuntime) ValidateRuntime(context.Context, *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList) {
+	return nil, f.validateRuntimeErrs
+}
+
+func TestReconcile_TrainJobReconciler(t *testing.T) {
+	errorFailedNewObjects := errors.New("TEST: failed to build objects")
+	errorFailedUpdateStatus := errors.New("TEST: failed to update status")
+	now := time.Now().Truncate(time.Second)
+	cases := map[string]struct {
+		trainJob        *trainer.TrainJob
+		runtime         *fakeRuntime
+		updateStatusErr error
+		wantStatus      trainer.TrainJobStatus
+		wantAnnotations map[string]string
+		wantResult      reconcile.Result
+		wantError       error
+	}{
+		"Created condition and startTime are set when resources are created": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				Obj(),
+			runtime: &fakeRuntime{},
+			wantStatus: trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobCreated,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobResourcesCreatedReason,
+						Message: constants.TrainJobResourcesCreatedMessage,
+					},
+				},
+				StartTime: ptr.To(metav1.NewTime(now)),
+			},
+		},
+		"TrainJob managed by an external controller is not reconciled": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				ManagedBy(trainer.MultiKueueControllerName).
+				Obj(),
+			runtime: &fakeRuntime{
+				newObjectsErr: errorFailedNewObjects,
+			},
+		},
+		"Running condition is set when the TrainJob is running": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				Obj(),
+			runtime: &fakeRuntime{
+				runningCondition: &metav1.Condition{
+					Type:    trainer.TrainJobRunning,
+					Status:  metav1.ConditionTrue,
+					Reason:  trainer.TrainJobPodsRunningReason,
+					Message: constants.TrainJobPodsRunningMessage,
+				},
+			},
+			wantStatus: trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobCreated,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobResourcesCreatedReason,
+						Message: constants.TrainJobResourcesCreatedMessage,
+					},
+					{
+						Type:    trainer.TrainJobRunning,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobPodsRunningReason,
+						Message: constants.TrainJobPodsRunningMessage,
+					},
+				},
+				StartTime: ptr.To(metav1.NewTime(now)),
+			},
+		},
+		"startTime is reset and the active time is accumulated when the TrainJob is suspended": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				Suspend(true).
+				StartTime(metav1.NewTime(now.Add(-time.Minute))).
+				ActiveSeconds(30).
+				Obj(),
+			runtime: &fakeRuntime{},
+			wantStatus: trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobCreated,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobResourcesCreatedReason,
+						Message: constants.TrainJobResourcesCreatedMessage,
+					},
+					{
+						Type:    trainer.TrainJobSuspended,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobSuspendedReason,
+						Message: constants.TrainJobSuspendedMessage,
+					},
+				},
+				ActiveSeconds: ptr.To[int64](90),
+			},
+		},
+		"completionTime is set when the TrainJob is finished": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				StartTime(metav1.NewTime(now.Add(-time.Minute))).
+				Obj(),
+			runtime: &fakeRuntime{
+				terminalCondition: &metav1.Condition{
+					Type:    trainer.TrainJobComplete,
+					Status:  metav1.ConditionTrue,
+					Reason:  "Completed",
+					Message: "jobs completed",
+				},
+			},
+			wantStatus: trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobCreated,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobResourcesCreatedReason,
+						Message: constants.TrainJobResourcesCreatedMessage,
+					},
+					{
+						Type:    trainer.TrainJobComplete,
+						Status:  metav1.ConditionTrue,
+						Reason:  "Completed",
+						Message: "jobs completed",
+					},
+				},
+				StartTime:      ptr.To(metav1.NewTime(now.Add(-time.Minute))),
+				CompletionTime: ptr.To(metav1.NewTime(now)),
+			},
+		},
+		"TrainJob is requeued until the activeDeadlineSeconds is reached": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				ActiveDeadlineSeconds(120).
+				StartTime(metav1.NewTime(now.Add(-time.Minute))).
+				Obj(),
+			runtime: &fakeRuntime{},
+			wantStatus: trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobCreated,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobResourcesCreatedReason,
+						Message: constants.TrainJobResourcesCreatedMessage,
+					},
+				},
+				StartTime: ptr.To(metav1.NewTime(now.Add(-time.Minute))),
+			},
+			wantResult: reconcile.Result{RequeueAfter: time.Minute},
+		},
+		"resumed TrainJob is requeued until the activeDeadlineSeconds minus the accumulated active time is reached": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				ActiveDeadlineSeconds(180).
+				StartTime(metav1.NewTime(now.Add(-time.Minute))).
+				ActiveSeconds(60).
+				Obj(),
+			runtime: &fakeRuntime{},
+			wantStatus: trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobCreated,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobResourcesCreatedReason,
+						Message: constants.TrainJobResourcesCreatedMessage,
+					},
+				},
+				StartTime:     ptr.To(metav1.NewTime(now.Add(-time.Minute))),
+				ActiveSeconds: ptr.To[int64](60),
+			},
+			wantResult: reconcile.Result{RequeueAfter: time.Minute},
+		},
+		"Failed condition is set when the active time before and after the suspension exceeds the activeDeadlineSeconds": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				ActiveDeadlineSeconds(90).
+				StartTime(metav1.NewTime(now.Add(-time.Minute))).
+				ActiveSeconds(30).
+				Obj(),
+			runtime: &fakeRuntime{},
+			wantStatus: trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobFailed,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobDeadlineExceededReason,
+						Message: constants.TrainJobDeadlineExceededMessage,
+					},
+					{
+						Type:    trainer.TrainJobCreated,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobResourcesCreatedReason,
+						Message: constants.TrainJobResourcesCreatedMessage,
+					},
+				},
+				StartTime:      ptr.To(metav1.NewTime(now.Add(-time.Minute))),
+				CompletionTime: ptr.To(metav1.NewTime(now)),
+				ActiveSeconds:  ptr.To[int64](30),
+			},
+		},
+		"Failed condition is set when the activeDeadlineSeconds is exceeded": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				ActiveDeadlineSeconds(60).
+				StartTime(metav1.NewTime(now.Add(-time.Minute))).
+				Obj(),
+			runtime: &fakeRuntime{},
+			wantStatus: trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobFailed,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobDeadlineExceededReason,
+						Message: constants.TrainJobDeadlineExceededMessage,
+					},
+					{
+						Type:    trainer.TrainJobCreated,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobResourcesCreatedReason,
+						Message: constants.TrainJobResourcesCreatedMessage,
+					},
+				},
+				StartTime:      ptr.To(metav1.NewTime(now.Add(-time.Minute))),
+				CompletionTime: ptr.To(metav1.NewTime(now)),
+			},
+		},
+		"activeDeadlineSeconds is not enforced while the TrainJob is suspended": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				ActiveDeadlineSeconds(60).
+				Suspend(true).
+				StartTime(metav1.NewTime(now.Add(-time.Hour))).
+				Obj(),
+			runtime: &fakeRuntime{},
+			wantStatus: trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobCreated,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobResourcesCreatedReason,
+						Message: constants.TrainJobResourcesCreatedMessage,
+					},
+					{
+						Type:    trainer.TrainJobSuspended,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobSuspendedReason,
+						Message: constants.TrainJobSuspendedMessage,
+					},
+				},
+				ActiveSeconds: ptr.To[int64](3600),
+			},
+		},
+		"Created condition is false and retries are tracked when resources creation failed": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				ResourcesCreationRetries(1).
+				Obj(),
+			runtime:   &fakeRuntime{newObjectsErr: errorFailedNewObjects},
+			wantError: errorFailedNewObjects,
+			wantStatus: trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobCreated,
+						Status:  metav1.ConditionFalse,
+						Reason:  trainer.TrainJobResourcesCreationFailedReason,
+						Message: "TrainJob resources reconciliation failed: TEST: failed to build objects",
+					},
+				},
+				ResourcesCreationRetries:         ptr.To[int32](2),
+				LastResourcesCreationAttemptTime: ptr.To(metav1.NewTime(now)),
+			},
+		},
+		"runtime snapshot is kept when the runtime is updated": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				RuntimeSnapshot("trainJob-old", 1).
+				Obj(),
+			runtime: &fakeRuntime{
+				runtimeSnapshot: &trainer.RuntimeSnapshot{Name: "trainJob-new", RuntimeGeneration: 2},
+			},
+			wantStatus: trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobCreated,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobResourcesCreatedReason,
+						Message: constants.TrainJobResourcesCreatedMessage,
+					},
+				},
+				StartTime:       ptr.To(metav1.NewTime(now)),
+				RuntimeSnapshot: &trainer.RuntimeSnapshot{Name: "trainJob-old", RuntimeGeneration: 1},
+			},
+		},
+		"runtime snapshot is re-resolved to the latest runtime by the annotation": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				Annotation(constants.AnnotationReResolveRuntime, "true").
+				Annotation("key", "value").
+				RuntimeSnapshot("trainJob-old", 1).
+				Obj(),
+			runtime: &fakeRuntime{
+				runtimeSnapshot: &trainer.RuntimeSnapshot{Name: "trainJob-new", RuntimeGeneration: 2},
+			},
+			wantStatus: trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobCreated,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobResourcesCreatedReason,
+						Message: constants.TrainJobResourcesCreatedMessage,
+					},
+				},
+				StartTime:       ptr.To(metav1.NewTime(now)),
+				RuntimeSnapshot: &trainer.RuntimeSnapshot{Name: "trainJob-new", RuntimeGeneration: 2},
+			},
+			wantAnnotations: map[string]string{"key": "value"},
+		},
+		"re-resolve annotation is kept when the status update dropping the runtime snapshot fails": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				Annotation(constants.AnnotationReResolveRuntime, "true").
+				Annotation("key", "value").
+				RuntimeSnapshot("trainJob-old", 1).
+				Obj(),
+			runtime: &fakeRuntime{
+				runtimeSnapshot: &trainer.RuntimeSnapshot{Name: "trainJob-new", RuntimeGeneration: 2},
+			},
+			updateStatusErr: errorFailedUpdateStatus,
+			wantError:       errorFailedUpdateStatus,
+			wantStatus: trainer.TrainJobStatus{
+				RuntimeSnapshot: &trainer.RuntimeSnapshot{Name: "trainJob-old", RuntimeGeneration: 1},
+			},
+			wantAnnotations: map[string]string{constants.AnnotationReResolveRuntime: "true", "key": "value"},
+		},
+		"retries are reset when resources are created after failures": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+				ResourcesCreationRetries(3).
+				LastResourcesCreationAttemptTime(metav1.NewTime(now.Add(-time.Minute))).
+				Obj(),
+			runtime: &fakeRuntime{},
+			wantStatus: trainer.TrainJobStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:    trainer.TrainJobCreated,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobResourcesCreatedReason,
+						Message: constants.TrainJobResourcesCreatedMessage,
+					},
+				},
+				StartTime: ptr.To(metav1.NewTime(now)),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			cli := utiltesting.NewClientBuilder().
+				WithObjects(tc.trainJob).
+				WithStatusSubresource(tc.trainJob).
+				WithInterceptorFuncs(interceptor.Funcs{
+					SubResourceUpdate: func(ctx context.Context, cli client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						if tc.updateStatusErr != nil {
+							return tc.updateStatusErr
+						}
+						return cli.SubResource(subResourceName).Update(ctx, obj, opts...)
+					},
+				}).
+				Build()
+			runtimes := map[string]jobruntimes.Runtime{
+				jobruntimes.RuntimeRefToRuntimeRegistryKey(tc.trainJob.Spec.RuntimeRef): tc.runtime,
+			}
+			r := NewTrainJobReconciler(cli, record.NewFakeRecorder(10), runtimes, WithClock(testingclock.NewFakeClock(now)))
+			trainJobKey := client.ObjectKeyFromObject(tc.trainJob)
+			gotResult, gotError := r.Reconcile(ctx, reconcile.Request{NamespacedName: trainJobKey})
+			if diff := cmp.Diff(tc.wantError, gotError, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected Reconcile error: (-want, +got): \n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantResult, gotResult); len(diff) != 0 {
+				t.Errorf("Unexpected Reconcile result: (-want, +got): \n%s", diff)
+			}
+			var gotTrainJob trainer.TrainJob
+			if err := cli.Get(ctx, trainJobKey, &gotTrainJob); err != nil {
+				t.Fatalf("Failed to get TrainJob: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantStatus, gotTrainJob.Status,
+				cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
+				cmpopts.EquateApproxTime(time.Second),
+			); len(diff) != 0 {
+				t.Errorf("Unexpected status: (-want, +got): \n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantAnnotations, gotTrainJob.Annotations); len(diff) != 0 {
+				t.Errorf("Unexpected annotations: (-want, +got): \n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcile_FinishedTrainJob(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	complete := metav1.Condition{
+		Type:   trainer.TrainJobComplete,
+		Status: metav1.ConditionTrue,
+		Reason: "Completed",
+	}
+	failed := metav1.Condition{
+		Type:   trainer.TrainJobFailed,
+		Status: metav1.ConditionTrue,
+		Reason: "Failed",
+	}
+	finishedTrainJob := func(name string, condition metav1.Condition, completionTime time.Time) *utiltesting.TrainJobWrapper {
+		return utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, name).
+			RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+			Condition(condition).
+			CompletionTime(metav1.NewTime(completionTime))
+	}
+	cases := map[string]struct {
+		namespace         *corev1.Namespace
+		trainJobs         []*trainer.TrainJob
+		terminalCondition *metav1.Condition
+		wantResult        reconcile.Result
+		wantTrainJobs     []string
+	}{
+		"finished TrainJob without TTL is kept": {
+			trainJobs: []*trainer.TrainJob{
+				finishedTrainJob("trainJob", complete, now.Add(-time.Hour)).Obj(),
+			},
+			wantTrainJobs: []string{"trainJob"},
+		},
+		"finished TrainJob is requeued until the TTL expires": {
+			trainJobs: []*trainer.TrainJob{
+				finishedTrainJob("trainJob", complete, now.Add(-30*time.Second)).
+					TTLSecondsAfterFinished(60).
+					Obj(),
+			},
+			wantResult:    reconcile.Result{RequeueAfter: 30 * time.Second},
+			wantTrainJobs: []string{"trainJob"},
+		},
+		"finished TrainJob is deleted once the TTL expires": {
+			trainJobs: []*trainer.TrainJob{
+				finishedTrainJob("trainJob", failed, now.Add(-time.Minute)).
+					TTLSecondsAfterFinished(60).
+					Obj(),
+			},
+		},
+		"oldest finished TrainJobs exceeding the Namespace history limits are deleted once the TrainJob finishes": {
+			namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: metav1.NamespaceDefault,
+					Annotations: map[string]string{
+						constants.AnnotationSuccessfulTrainJobsHistoryLimit: "2",
+						constants.AnnotationFailedTrainJobsHistoryLimit:     "0",
+					},
+				},
+			},
+			trainJobs: []*trainer.TrainJob{
+				utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+					RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+					Obj(),
+				finishedTrainJob("complete-1", complete, now.Add(-3*time.Minute)).Obj(),
+				finishedTrainJob("complete-2", complete, now.Add(-2*time.Minute)).Obj(),
+				finishedTrainJob("failed", failed, now.Add(-time.Minute)).Obj(),
+				finishedTrainJob("other-runtime", complete, now.Add(-time.Hour)).
+					RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "other").
+					Obj(),
+				finishedTrainJob("other-runtime-kind", complete, now.Add(-time.Hour)).
+					RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), "runtime").
+					Obj(),
+				utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "running").
+					RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+					Obj(),
+			},
+			terminalCondition: &complete,
+			wantTrainJobs:     []string{"complete-2", "other-runtime", "other-runtime-kind", "running", "trainJob"},
+		},
+		"Namespace history limits are not enforced again for the TrainJob which has already finished": {
+			namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: metav1.NamespaceDefault,
+					Annotations: map[string]string{
+						constants.AnnotationSuccessfulTrainJobsHistoryLimit: "0",
+					},
+				},
+			},
+			trainJobs: []*trainer.TrainJob{
+				finishedTrainJob("trainJob", complete, now.Add(-time.Minute)).Obj(),
+				finishedTrainJob("complete", complete, now.Add(-2*time.Minute)).Obj(),
+			},
+			wantTrainJobs: []string{"complete", "trainJob"},
+		},
+		"invalid Namespace history limit is ignored": {
+			namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: metav1.NamespaceDefault,
+					Annotations: map[string]string{
+						constants.AnnotationSuccessfulTrainJobsHistoryLimit: "invalid",
+					},
+				},
+			},
+			trainJobs: []*trainer.TrainJob{
+				utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+					RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "runtime").
+					Obj(),
+				finishedTrainJob("complete", complete, now.Add(-2*time.Minute)).Obj(),
+			},
+			terminalCondition: &complete,
+			wantTrainJobs:     []string{"complete", "trainJob"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			clientBuilder := utiltesting.NewClientBuilder().
+				WithIndex(&trainer.TrainJob{}, idxer.TrainJobRuntimeRefKey, idxer.IndexTrainJobTrainingRuntime).
+				WithIndex(&trainer.TrainJob{}, idxer.TrainJobClusterRuntimeRefKey, idxer.IndexTrainJobClusterTrainingRuntime)
+			if tc.namespace != nil {
+				clientBuilder.WithObjects(tc.namespace)
+			}
+			for _, trainJob := range tc.trainJobs {
+				clientBuilder.WithObjects(trainJob).WithStatusSubresource(trainJob)
+			}
+			cli := clientBuilder.Build()
+			runtimes := map[string]jobruntimes.Runtime{
+				jobruntimes.RuntimeRefToRuntimeRegistryKey(tc.trainJobs[0].Spec.RuntimeRef): &fakeRuntime{terminalCondition: tc.terminalCondition},
+			}
+			r := NewTrainJobReconciler(cli, record.NewFakeRecorder(10), runtimes, WithClock(testingclock.NewFakeClock(now)))
+			gotResult, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tc.trainJobs[0])})
+			if err != nil {
+				t.Fatalf("Unexpected Reconcile error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, gotResult); len(diff) != 0 {
+				t.Errorf("Unexpected Reconcile result: (-want, +got): \n%s", diff)
+			}
+			var trainJobs trainer.TrainJobList
+			if err = cli.List(ctx, &trainJobs); err != nil {
+				t.Fatalf("Failed to list TrainJobs: %v", err)
+			}
+			var gotTrainJobs []string
+			for _, trainJob := range trainJobs.Items {
+				gotTrainJobs = append(gotTrainJobs, trainJob.Name)
+			}
+			if diff := cmp.Diff(tc.wantTrainJobs, gotTrainJobs, cmpopts.SortSlices(func(a, b string) bool { return a < b })); len(diff) != 0 {
+				t.Errorf("Unexpected TrainJobs: (-want, +got): \n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate_TrainJobReconciler(t *testing.T) {
+	cases := map[string]struct {
+		trainJob    *trainer.TrainJob
+		wantCreated float64
+	}{
+		"new TrainJob is counted as created": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "new").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "created-runtime").
+				Obj(),
+			wantCreated: 1,
+		},
+		"reconciled TrainJob listed after the controller restart is not counted as created": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "existing").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "restarted-runtime").
+				Condition(metav1.Condition{
+					Type:   trainer.TrainJobCreated,
+					Status: metav1.ConditionTrue,
+					Reason: trainer.TrainJobResourcesCreatedReason,
+				}).
+				Obj(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			logger, _ := ktesting.NewTestContext(t)
+			r := &TrainJobReconciler{log: logger, watchers: slices.Values([]TrainJobWatcher{})}
+			r.Create(event.TypedCreateEvent[*trainer.TrainJob]{Object: tc.trainJob})
+			got := testutil.ToFloat64(metrics.TrainJobsCreatedTotal.WithLabelValues(
+				tc.trainJob.Namespace, trainer.TrainingRuntimeKind, tc.trainJob.Spec.RuntimeRef.Name))
+			if got != tc.wantCreated {
+				t.Errorf("Unexpected created TrainJobs: want %v, got %v", tc.wantCreated, got)
+			}
+		})
+	}
+}
+
+func TestUpdate_TrainJobReconciler(t *testing.T) {
+	cases := map[string]struct {
+		oldJob *trainer.TrainJob
+		newJob *trainer.TrainJob
+		want   bool
+	}{
+		"status-only update is not reconciled": {
+			oldJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Obj(),
+			newJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				ResourcesCreationRetries(1).
+				Obj(),
+			want: false,
+		},
+		"spec update is reconciled": {
+			oldJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Suspend(true).
+				Obj(),
+			newJob: func() *trainer.TrainJob {
+				trainJob := utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+					Suspend(false).
+					Obj()
+				trainJob.Generation = 1
+				return trainJob
+			}(),
+			want: true,
+		},
+		"annotation update is reconciled": {
+			oldJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Obj(),
+			newJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Annotation(constants.AnnotationReResolveRuntime, "").
+				Obj(),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			logger, _ := ktesting.NewTestContext(t)
+			r := &TrainJobReconciler{log: logger, watchers: slices.Values([]TrainJobWatcher{})}
+			got := r.Update(event.TypedUpdateEvent[*trainer.TrainJob]{ObjectOld: tc.oldJob, ObjectNew: tc.newJob})
+			if got != tc.want {
+				t.Errorf("Unexpected Update predicate result: want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+)
+
+const (
+	TrainerSubsystemName = "trainer"
+)
+
+var (
+	// TrainJobsCreatedTotal counts the TrainJobs observed by the TrainJob controller on their creation.
+	TrainJobsCreatedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: TrainerSubsystemName,
+			Name:      "trainjobs_created_total",
+			Help:      "The total number of TrainJobs created per namespace and runtime kind and name",
+		}, []string{"namespace", "runtime_kind", "runtime"},
+	)
+
+	// TrainJobsCompletedTotal counts the TrainJobs which have reached the Complete condition.
+	TrainJobsCompletedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: TrainerSubsystemName,
+			Name:      "trainjobs_completed_total",
+			Help:      "The total number of TrainJobs completed per namespace and runtime kind and name",
+		}, []string{"namespace", "runtime_kind", "runtime"},
+	)
+
+	// TrainJobsFailedTotal counts the TrainJobs which have reached the Failed condition.
+	TrainJobsFailedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: TrainerSubsystemName,
+			Name:      "trainjobs_failed_total",
+			Help:      "The total number of TrainJobs failed per namespace and runtime kind and name",
+		}, []string{"namespace", "runtime_kind", "runtime"},
+	)
+
+	TrainJobTimeToRunningSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: TrainerSubsystemName,
+			Name:      "trainjob_time_to_running_seconds",
+			Help:      "The time from the TrainJob creation until its Running condition becomes true for the first time",
+			Buckets:   generateExponentialBuckets(14),
+		}, []string{"namespace", "runtime_kind", "runtime"},
+	)
+
+	TrainJobTimeToCompletionSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: TrainerSubsystemName,
+			Name:      "trainjob_time_to_completion_seconds",
+			Help: `The time from the TrainJob creation until it is finished per result.
+The result can be "Complete" or "Failed"`,
+			Buckets: generateExponentialBuckets(18),
+		}, []string{"namespace", "runtime_kind", "runtime", "result"},
+	)
+
+	ActiveTrainJobs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: TrainerSubsystemName,
+			Name:      "active_trainjobs",
+			Help:      "The number of TrainJobs which are not finished per namespace and runtime kind and name",
+		}, []string{"namespace", "runtime_kind", "runtime"},
+	)
+
+	PodSetNodes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: TrainerSubsystemName,
+			Name:      "trainjob_podset_nodes",
+			Help:      "The number of Pods in each PodSet of the TrainJob",
+		}, []string{"namespace", "trainjob", "podset"},
+	)
+
+	ComponentBuildDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: TrainerSubsystemName,
+			Name:      "component_build_duration_seconds",
+			Help:      "The latency of building the TrainJob components per ComponentBuilder plugin",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 16),
+		}, []string{"plugin"},
+	)
+)
+
+// runningObserved records the TrainJobs whose time to running has been observed
+// so that it is observed only once even if the Running condition flips back to true.
+var runningObserved sync.Map
+
+// runtimeLabels returns the values of the runtime kind and name labels for the TrainJob.
+func runtimeLabels(trainJob *trainer.TrainJob) (string, string) {
+	return ptr.Deref(trainJob.Spec.RuntimeRef.Kind, trainer.ClusterTrainingRuntimeKind), trainJob.Spec.RuntimeRef.Name
+}
+
+func TrainJobCreated(trainJob *trainer.TrainJob) {
+	kind, runtime := runtimeLabels(trainJob)
+	TrainJobsCreatedTotal.WithLabelValues(trainJob.Namespace, kind, runtime).Inc()
+}
+
+// TrainJobRunning reports the time to running only the first time the TrainJob is running.
+func TrainJobRunning(trainJob *trainer.TrainJob, now time.Time) {
+	if _, observed := runningObserved.LoadOrStore(types.NamespacedName{Namespace: trainJob.Namespace, Name: trainJob.Name}, struct{}{}); observed {
+		return
+	}
+	kind, runtime := runtimeLabels(trainJob)
+	TrainJobTimeToRunningSeconds.WithLabelValues(trainJob.Namespace, kind, runtime).
+		Observe(now.Sub(trainJob.CreationTimestamp.Time).Seconds())
+}
+
+// TrainJobFinished reports the TrainJob completion with the result, which is "Complete" or "Failed".
+func TrainJobFinished(trainJob *trainer.TrainJob, result string, now time.Time) {
+	kind, runtime := runtimeLabels(trainJob)
+	switch result {
+	case trainer.TrainJobComplete:
+		TrainJobsCompletedTotal.WithLabelValues(trainJob.Namespace, kind, runtime).Inc()
+	case trainer.TrainJobFailed:
+		TrainJobsFailedTotal.WithLabelValues(trainJob.Namespace, kind, runtime).Inc()
+	}
+	TrainJobTimeToCompletionSeconds.WithLabelValues(trainJob.Namespace, kind, runtime, result).
+		Observe(now.Sub(trainJob.CreationTimestamp.Time).Seconds())
+}
+
+func IncActiveTrainJobs(trainJob *trainer.TrainJob) {
+	kind, runtime := runtimeLabels(trainJob)
+	ActiveTrainJobs.WithLabelValues(trainJob.Namespace, kind, runtime).Inc()
+}
+
+func DecActiveTrainJobs(trainJob *trainer.TrainJob) {
+	kind, runtime := runtimeLabels(trainJob)
+	ActiveTrainJobs.WithLabelValues(trainJob.Namespace, kind, runtime).Dec()
+}
+
+func ReportPodSetNodes(trainJob *trainer.TrainJob, podSet string, count int32) {
+	PodSetNodes.WithLabelValues(trainJob.Namespace, trainJob.Name, podSet).Set(float64(count))
+}
+
+// ClearTrainJobMetrics removes the metrics labeled with the TrainJob name.
+func ClearTrainJobMetrics(namespace, name string) {
+	PodSetNodes.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "trainjob": name})
+	runningObserved.Delete(types.NamespacedName{Namespace: namespace, Name: name})
+}
+
+func ComponentBuildDuration(plugin string, duration time.Duration) {
+	ComponentBuildDurationSeconds.WithLabelValues(plugin).Observe(duration.Seconds())
+}
+
+// generateExponentialBuckets returns the 0.5 second bucket followed by count buckets starting from 1 second and doubling.
+func generateExponentialBuckets(count int) []float64 {
+	return append([]float64{0.5}, prometheus.ExponentialBuckets(1, 2, count)...)
+}
+
+// Register registers the trainer metrics with the controller-runtime metrics registry.
+func Register() {
+	metrics.Registry.MustRegister(
+		TrainJobsCreatedTotal,
+		TrainJobsCompletedTotal,
+		TrainJobsFailedTotal,
+		TrainJobTimeToRunningSeconds,
+		TrainJobTimeToCompletionSeconds,
+		ActiveTrainJobs,
+		PodSetNodes,
+		ComponentBuildDurationSeconds,
+	)
+}
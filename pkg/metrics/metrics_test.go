@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+func TestTrainJobFinished(t *testing.T) {
+	created := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	trainJob := utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+		RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), "torch-distributed").
+		Obj()
+	trainJob.CreationTimestamp = metav1.NewTime(created)
+
+	TrainJobFinished(trainJob, trainer.TrainJobComplete, created.Add(time.Minute))
+	TrainJobFinished(trainJob, trainer.TrainJobFailed, created.Add(time.Hour))
+
+	if got := testutil.ToFloat64(TrainJobsCompletedTotal.WithLabelValues(metav1.NamespaceDefault, trainer.ClusterTrainingRuntimeKind, "torch-distributed")); got != 1 {
+		t.Errorf("Unexpected completed TrainJobs, want 1, got %v", got)
+	}
+	if got := testutil.ToFloat64(TrainJobsFailedTotal.WithLabelValues(metav1.NamespaceDefault, trainer.ClusterTrainingRuntimeKind, "torch-distributed")); got != 1 {
+		t.Errorf("Unexpected failed TrainJobs, want 1, got %v", got)
+	}
+	if got := testutil.CollectAndCount(TrainJobTimeToCompletionSeconds); got != 2 {
+		t.Errorf("Unexpected number of time to completion series, want 2, got %d", got)
+	}
+}
+
+func TestTrainJobRunning(t *testing.T) {
+	created := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	trainJob := utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "running").
+		RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "torch-distributed").
+		Obj()
+	trainJob.CreationTimestamp = metav1.NewTime(created)
+	t.Cleanup(func() { ClearTrainJobMetrics(metav1.NamespaceDefault, "running") })
+
+	TrainJobRunning(trainJob, created.Add(time.Minute))
+	// The Running condition flips back to true after the TrainJob is resumed.
+	TrainJobRunning(trainJob, created.Add(time.Hour))
+
+	histogram := TrainJobTimeToRunningSeconds.WithLabelValues(metav1.NamespaceDefault, trainer.TrainingRuntimeKind, "torch-distributed")
+	if got := testutil.CollectAndCount(TrainJobTimeToRunningSeconds); got != 1 {
+		t.Errorf("Unexpected number of time to running series, want 1, got %d", got)
+	}
+	var m dto.Metric
+	if err := histogram.(prometheus.Histogram).Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	if got := m.GetHistogram().GetSampleCount(); got != 1 {
+		t.Errorf("Unexpected number of time to running observations, want 1, got %d", got)
+	}
+}
+
+func TestClearTrainJobMetrics(t *testing.T) {
+	trainJob := utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").Obj()
+	other := utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "other").Obj()
+	ReportPodSetNodes(trainJob, "node", 4)
+	ReportPodSetNodes(trainJob, "launcher", 1)
+	ReportPodSetNodes(other, "node", 2)
+
+	ClearTrainJobMetrics(metav1.NamespaceDefault, "test")
+
+	if got := testutil.CollectAndCount(PodSetNodes); got != 1 {
+		t.Errorf("Unexpected number of PodSet nodes series, want 1, got %d", got)
+	}
+	if got := testutil.ToFloat64(PodSetNodes.WithLabelValues(metav1.NamespaceDefault, "other", "node")); got != 2 {
+		t.Errorf("Unexpected PodSet nodes, want 2, got %v", got)
+	}
+}
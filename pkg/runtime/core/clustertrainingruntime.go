@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -29,6 +30,7 @@ import (
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	fwkplugins "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins"
 )
 
 var (
@@ -46,38 +48,73 @@ var ClusterTrainingRuntimeGroupKind = schema.GroupKind{
 	Kind:  trainer.ClusterTrainingRuntimeKind,
 }.String()
 
-func NewClusterTrainingRuntime(context.Context, client.Client, client.FieldIndexer) (runtime.Runtime, error) {
+func NewClusterTrainingRuntime(context.Context, client.Client, client.FieldIndexer, fwkplugins.Registry) (runtime.Runtime, error) {
 	return &ClusterTrainingRuntime{
 		TrainingRuntime: trainingRuntimeFactory,
 	}, nil
 }
 
 func (r *ClusterTrainingRuntime) NewObjects(ctx context.Context, trainJob *trainer.TrainJob) ([]any, error) {
+	spec, err := r.runtimeSpec(ctx, trainJob, true, r.latestRuntimeSpec)
+	if err != nil {
+		return nil, err
+	}
+	return r.buildObjects(ctx, trainJob, spec.Template, spec.MLPolicy, spec.PodGroupPolicy)
+}
+
+func (r *ClusterTrainingRuntime) latestRuntimeSpec(ctx context.Context, trainJob *trainer.TrainJob) (*trainer.TrainingRuntimeSpec, int64, error) {
 	var clTrainingRuntime trainer.ClusterTrainingRuntime
 	if err := r.client.Get(ctx, client.ObjectKey{Name: trainJob.Spec.RuntimeRef.Name}, &clTrainingRuntime); err != nil {
-		return nil, fmt.Errorf("%w: %w", errorNotFoundSpecifiedClusterTrainingRuntime, err)
+		return nil, 0, fmt.Errorf("%w: %w", errorNotFoundSpecifiedClusterTrainingRuntime, err)
 	}
-	return r.buildObjects(ctx, trainJob, clTrainingRuntime.Spec.Template, clTrainingRuntime.Spec.MLPolicy, clTrainingRuntime.Spec.PodGroupPolicy)
+	return &clTrainingRuntime.Spec, clTrainingRuntime.Generation, nil
 }
 
 func (r *ClusterTrainingRuntime) TerminalCondition(ctx context.Context, trainJob *trainer.TrainJob) (*metav1.Condition, error) {
 	return r.TrainingRuntime.TerminalCondition(ctx, trainJob)
 }
 
+func (r *ClusterTrainingRuntime) RunningCondition(ctx context.Context, trainJob *trainer.TrainJob) (*metav1.Condition, error) {
+	return r.TrainingRuntime.RunningCondition(ctx, trainJob)
+}
+
+func (r *ClusterTrainingRuntime) JobsStatus(ctx context.Context, trainJob *trainer.TrainJob) ([]trainer.JobStatus, *int32, error) {
+	return r.TrainingRuntime.JobsStatus(ctx, trainJob)
+}
+
 func (r *ClusterTrainingRuntime) EventHandlerRegistrars() []runtime.ReconcilerBuilder {
 	return nil
 }
 
 func (r *ClusterTrainingRuntime) ValidateObjects(ctx context.Context, old, new *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
-	clusterTrainingRuntime := &trainer.ClusterTrainingRuntime{}
-	if err := r.client.Get(ctx, client.ObjectKey{
-		Name: new.Spec.RuntimeRef.Name,
-	}, clusterTrainingRuntime); err != nil {
+	// The TrainJob is validated against the runtime snapshot pinned to it when it exists.
+	spec, err := r.runtimeSpec(ctx, new, false, r.latestRuntimeSpec)
+	if err != nil {
 		return nil, field.ErrorList{
 			field.Invalid(field.NewPath("spec", "RuntimeRef"), new.Spec.RuntimeRef,
 				fmt.Sprintf("%v: specified clusterTrainingRuntime must be created before the TrainJob is created", err)),
 		}
 	}
-	info, _ := r.newRuntimeInfo(new, clusterTrainingRuntime.Spec.Template, clusterTrainingRuntime.Spec.MLPolicy, clusterTrainingRuntime.Spec.PodGroupPolicy)
-	return r.framework.RunCustomValidationPlugins(ctx, info, old, new)
+	info, _ := r.newRuntimeInfo(new, spec.Template, spec.MLPolicy, spec.PodGroupPolicy)
+	warnings, allErrs := validateDeprecation(old, new, trainer.ClusterTrainingRuntimeKind, spec.Deprecation, time.Now())
+	allErrs = append(allErrs, r.validatePodGroupPolicyPlugin(old, spec.PodGroupPolicy)...)
+	pluginWarnings, errs := r.framework.RunCustomValidationPlugins(ctx, info, old, new)
+	return append(warnings, pluginWarnings...), append(allErrs, errs...)
+}
+
+func (r *ClusterTrainingRuntime) ValidateRuntime(ctx context.Context, spec *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList) {
+	return r.TrainingRuntime.ValidateRuntime(ctx, spec)
+}
+
+func (r *ClusterTrainingRuntime) DefaultObjects(ctx context.Context, trainJob *trainer.TrainJob) error {
+	spec, err := r.runtimeSpec(ctx, trainJob, false, r.latestRuntimeSpec)
+	if err != nil {
+		// The missing clusterTrainingRuntime is reported by the validating webhook.
+		return client.IgnoreNotFound(err)
+	}
+	info, err := r.newRuntimeInfo(trainJob, spec.Template, spec.MLPolicy, spec.PodGroupPolicy)
+	if err != nil {
+		return err
+	}
+	return r.framework.RunCustomDefaultingPlugins(ctx, info, trainJob)
 }
@@ -23,12 +23,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	fwkplugins "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins"
 )
 
 // +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=trainingruntimes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=clustertrainingruntimes,verbs=get;list;watch
 
-func New(ctx context.Context, client client.Client, indexer client.FieldIndexer) (map[string]runtime.Runtime, error) {
+func New(ctx context.Context, client client.Client, indexer client.FieldIndexer, plugins fwkplugins.Registry) (map[string]runtime.Runtime, error) {
 	registry := NewRuntimeRegistry()
 	runtimes := make(map[string]runtime.Runtime, len(registry))
 	for name, registrar := range registry {
@@ -36,7 +37,7 @@ func New(ctx context.Context, client client.Client, indexer client.FieldIndexer)
 			depRegistrar, depExist := registry[dep]
 			_, depRegistered := runtimes[dep]
 			if depExist && !depRegistered {
-				r, err := depRegistrar.factory(ctx, client, indexer)
+				r, err := depRegistrar.factory(ctx, client, indexer, plugins)
 				if err != nil {
 					return nil, fmt.Errorf("initializing runtime %q on which %q depends: %w", dep, name, err)
 				}
@@ -44,7 +45,7 @@ func New(ctx context.Context, client client.Client, indexer client.FieldIndexer)
 			}
 		}
 		if _, ok := runtimes[name]; !ok {
-			r, err := registrar.factory(ctx, client, indexer)
+			r, err := registrar.factory(ctx, client, indexer, plugins)
 			if err != nil {
 				return nil, fmt.Errorf("initializing runtime %q: %w", name, err)
 			}
@@ -22,11 +22,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	fwkplugins "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins"
 )
 
 type Registry map[string]RuntimeRegistrar
 type RuntimeRegistrar struct {
-	factory      func(ctx context.Context, client client.Client, indexer client.FieldIndexer) (runtime.Runtime, error)
+	factory      func(ctx context.Context, client client.Client, indexer client.FieldIndexer, plugins fwkplugins.Registry) (runtime.Runtime, error)
 	dependencies []string
 }
 
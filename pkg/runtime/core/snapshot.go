@@ -0,0 +1,114 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/rand"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=controllerrevisions,verbs=get;list;watch;create
+
+// latestRuntimeSpecFunc returns the latest runtime spec and generation referenced by the TrainJob.
+type latestRuntimeSpecFunc func(ctx context.Context, trainJob *trainer.TrainJob) (*trainer.TrainingRuntimeSpec, int64, error)
+
+// runtimeSpec returns the runtime spec which is used to build the TrainJob resources.
+// The spec is read from the snapshot pinned to the TrainJob when it exists so that the runtime updates
+// don't affect the existing TrainJobs. Otherwise, the latest runtime spec is resolved, and it is pinned
+// to the TrainJob by taking the snapshot when takeSnapshot is true.
+func (r *TrainingRuntime) runtimeSpec(ctx context.Context, trainJob *trainer.TrainJob, takeSnapshot bool, latest latestRuntimeSpecFunc) (*trainer.TrainingRuntimeSpec, error) {
+	if snapshot := trainJob.Status.RuntimeSnapshot; snapshot != nil {
+		spec, err := r.loadRuntimeSnapshot(ctx, trainJob.Namespace, snapshot.Name)
+		if err == nil {
+			return spec, nil
+		}
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		ctrl.LoggerFrom(ctx).V(2).Info("Runtime snapshot is not found, resolving the latest runtime", "controllerRevision", snapshot.Name)
+	}
+	spec, generation, err := latest(ctx, trainJob)
+	if err != nil {
+		return nil, err
+	}
+	if takeSnapshot {
+		name, err := r.saveRuntimeSnapshot(ctx, trainJob, spec, generation)
+		if err != nil {
+			return nil, err
+		}
+		trainJob.Status.RuntimeSnapshot = &trainer.RuntimeSnapshot{
+			Name:              name,
+			RuntimeGeneration: generation,
+		}
+	}
+	return spec, nil
+}
+
+func (r *TrainingRuntime) loadRuntimeSnapshot(ctx context.Context, namespace, name string) (*trainer.TrainingRuntimeSpec, error) {
+	var revision appsv1.ControllerRevision
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &revision); err != nil {
+		return nil, err
+	}
+	var spec trainer.TrainingRuntimeSpec
+	if err := json.Unmarshal(revision.Data.Raw, &spec); err != nil {
+		return nil, fmt.Errorf("decoding runtime snapshot %s: %w", name, err)
+	}
+	return &spec, nil
+}
+
+// saveRuntimeSnapshot stores the runtime spec in the ControllerRevision owned by the TrainJob.
+// The ControllerRevision name is derived from the spec hash, so the same spec is stored once.
+func (r *TrainingRuntime) saveRuntimeSnapshot(ctx context.Context, trainJob *trainer.TrainJob, spec *trainer.TrainingRuntimeSpec, generation int64) (string, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	hasher := fnv.New32a()
+	hasher.Write(data)
+	revision := &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", trainJob.Name, rand.SafeEncodeString(fmt.Sprint(hasher.Sum32()))),
+			Namespace: trainJob.Namespace,
+			Labels: map[string]string{
+				constants.LabelTrainJobName: trainJob.Name,
+			},
+		},
+		Data:     apiruntime.RawExtension{Raw: data},
+		Revision: generation,
+	}
+	if err = controllerutil.SetControllerReference(trainJob, revision, r.client.Scheme()); err != nil {
+		return "", err
+	}
+	if err = r.client.Create(ctx, revision); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("creating runtime snapshot: %w", err)
+	}
+	return revision.Name, nil
+}
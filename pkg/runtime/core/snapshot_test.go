@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	fwkplugins "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins"
+	testingutil "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+func TestRuntimeSpec(t *testing.T) {
+	cases := map[string]struct {
+		pinned          bool
+		runtimeSnapshot *trainer.RuntimeSnapshot
+		takeSnapshot    bool
+		wantNumNodes    int32
+		wantGeneration  *int64
+	}{
+		"snapshot is taken from the latest runtime": {
+			takeSnapshot:   true,
+			wantNumNodes:   3,
+			wantGeneration: ptr.To[int64](2),
+		},
+		"latest runtime is used without taking snapshot": {
+			wantNumNodes: 3,
+		},
+		"pinned snapshot is used after the runtime is updated": {
+			pinned:         true,
+			takeSnapshot:   true,
+			wantNumNodes:   1,
+			wantGeneration: ptr.To[int64](1),
+		},
+		"pinned snapshot is used without taking snapshot": {
+			pinned:         true,
+			wantNumNodes:   1,
+			wantGeneration: ptr.To[int64](1),
+		},
+		"lost snapshot is re-taken from the latest runtime": {
+			runtimeSnapshot: &trainer.RuntimeSnapshot{
+				Name:              "test-job-lost",
+				RuntimeGeneration: 1,
+			},
+			takeSnapshot:   true,
+			wantNumNodes:   3,
+			wantGeneration: ptr.To[int64](2),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			trainingRuntime := testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").Obj()
+			trainingRuntime.Generation = 1
+			trainingRuntime.Spec.MLPolicy = testingutil.MakeMLPolicyWrapper().WithNumNodes(1).Obj()
+			clientBuilder := testingutil.NewClientBuilder().WithObjects(trainingRuntime)
+			c := clientBuilder.Build()
+
+			runtime, err := NewTrainingRuntime(ctx, c, testingutil.AsIndex(clientBuilder), fwkplugins.NewRegistry())
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := runtime.(*TrainingRuntime)
+			trainJob := testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				UID("uid").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "test-runtime").
+				Obj()
+			trainJob.Status.RuntimeSnapshot = tc.runtimeSnapshot
+			if tc.pinned {
+				if _, err = r.runtimeSpec(ctx, trainJob, true, r.latestRuntimeSpec); err != nil {
+					t.Fatalf("Failed to take the runtime snapshot: %v", err)
+				}
+			}
+
+			// The runtime is updated after the snapshot is taken.
+			if err = c.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime); err != nil {
+				t.Fatal(err)
+			}
+			trainingRuntime.Generation = 2
+			trainingRuntime.Spec.MLPolicy.NumNodes = ptr.To[int32](3)
+			if err = c.Update(ctx, trainingRuntime); err != nil {
+				t.Fatal(err)
+			}
+
+			spec, err := r.runtimeSpec(ctx, trainJob, tc.takeSnapshot, r.latestRuntimeSpec)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantNumNodes, ptr.Deref(spec.MLPolicy.NumNodes, 0)); len(diff) != 0 {
+				t.Errorf("Unexpected numNodes (-want,+got):\n%s", diff)
+			}
+			var gotGeneration *int64
+			if trainJob.Status.RuntimeSnapshot != nil {
+				gotGeneration = &trainJob.Status.RuntimeSnapshot.RuntimeGeneration
+				var revision appsv1.ControllerRevision
+				if err = c.Get(ctx, client.ObjectKey{Namespace: trainJob.Namespace, Name: trainJob.Status.RuntimeSnapshot.Name}, &revision); err != nil {
+					t.Fatalf("Failed to get the runtime snapshot: %v", err)
+				}
+				if diff := cmp.Diff(trainJob.Name, revision.Labels[constants.LabelTrainJobName]); len(diff) != 0 {
+					t.Errorf("Unexpected TrainJob name label (-want,+got):\n%s", diff)
+				}
+				if !metav1.IsControlledBy(&revision, trainJob) {
+					t.Errorf("Runtime snapshot is not controlled by the TrainJob: %v", revision.OwnerReferences)
+				}
+			}
+			if diff := cmp.Diff(tc.wantGeneration, gotGeneration); len(diff) != 0 {
+				t.Errorf("Unexpected runtime generation (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTrainingRuntimeValidateObjectsWithRuntimeSnapshot(t *testing.T) {
+	cases := map[string]struct {
+		pinned      bool
+		wantErrsLen int
+	}{
+		"TrainJob is validated against the pinned snapshot after the runtime is deleted": {
+			pinned: true,
+		},
+		"TrainJob without snapshot is rejected after the runtime is deleted": {
+			wantErrsLen: 1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			trainingRuntime := testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").Obj()
+			clientBuilder := testingutil.NewClientBuilder().WithObjects(trainingRuntime)
+			c := clientBuilder.Build()
+
+			runtime, err := NewTrainingRuntime(ctx, c, testingutil.AsIndex(clientBuilder), fwkplugins.NewRegistry())
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := runtime.(*TrainingRuntime)
+			trainJob := testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				UID("uid").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "test-runtime").
+				Obj()
+			if tc.pinned {
+				if _, err = r.runtimeSpec(ctx, trainJob, true, r.latestRuntimeSpec); err != nil {
+					t.Fatalf("Failed to take the runtime snapshot: %v", err)
+				}
+			}
+			if err = c.Delete(ctx, trainingRuntime); err != nil {
+				t.Fatal(err)
+			}
+
+			_, errs := r.ValidateObjects(ctx, trainJob.DeepCopy(), trainJob)
+			if diff := cmp.Diff(tc.wantErrsLen, len(errs)); len(diff) != 0 {
+				t.Errorf("Unexpected number of errors (-want,+got):\n%s\nerrors: %v", diff, errs)
+			}
+		})
+	}
+}
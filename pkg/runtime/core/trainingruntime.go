@@ -22,7 +22,9 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -37,10 +39,14 @@ import (
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/apply"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/metrics"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 	fwkcore "github.com/kubeflow/trainer/v2/pkg/runtime/framework/core"
 	fwkplugins "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/coscheduling"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/volcano"
 	idxer "github.com/kubeflow/trainer/v2/pkg/runtime/indexer"
+	"github.com/kubeflow/trainer/v2/pkg/tracing"
 )
 
 var (
@@ -61,14 +67,14 @@ var _ runtime.Runtime = (*TrainingRuntime)(nil)
 
 var trainingRuntimeFactory *TrainingRuntime
 
-func NewTrainingRuntime(ctx context.Context, c client.Client, indexer client.FieldIndexer) (runtime.Runtime, error) {
+func NewTrainingRuntime(ctx context.Context, c client.Client, indexer client.FieldIndexer, plugins fwkplugins.Registry) (runtime.Runtime, error) {
 	if err := indexer.IndexField(ctx, &trainer.TrainJob{}, idxer.TrainJobRuntimeRefKey, idxer.IndexTrainJobTrainingRuntime); err != nil {
 		return nil, fmt.Errorf("setting index on TrainingRuntime for TrainJob: %w", err)
 	}
 	if err := indexer.IndexField(ctx, &trainer.TrainJob{}, idxer.TrainJobClusterRuntimeRefKey, idxer.IndexTrainJobClusterTrainingRuntime); err != nil {
 		return nil, fmt.Errorf("setting index on ClusterTrainingRuntime for TrainJob: %w", err)
 	}
-	fwk, err := fwkcore.New(ctx, c, fwkplugins.NewRegistry(), indexer)
+	fwk, err := fwkcore.New(ctx, c, plugins, indexer)
 	if err != nil {
 		return nil, err
 	}
@@ -80,34 +86,53 @@ func NewTrainingRuntime(ctx context.Context, c client.Client, indexer client.Fie
 }
 
 func (r *TrainingRuntime) NewObjects(ctx context.Context, trainJob *trainer.TrainJob) ([]any, error) {
+	spec, err := r.runtimeSpec(ctx, trainJob, true, r.latestRuntimeSpec)
+	if err != nil {
+		return nil, err
+	}
+	return r.buildObjects(ctx, trainJob, spec.Template, spec.MLPolicy, spec.PodGroupPolicy)
+}
+
+func (r *TrainingRuntime) latestRuntimeSpec(ctx context.Context, trainJob *trainer.TrainJob) (*trainer.TrainingRuntimeSpec, int64, error) {
 	var trainingRuntime trainer.TrainingRuntime
 	err := r.client.Get(ctx, client.ObjectKey{Namespace: trainJob.Namespace, Name: trainJob.Spec.RuntimeRef.Name}, &trainingRuntime)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %w", errorNotFoundSpecifiedTrainingRuntime, err)
+		return nil, 0, fmt.Errorf("%w: %w", errorNotFoundSpecifiedTrainingRuntime, err)
 	}
-	return r.buildObjects(ctx, trainJob, trainingRuntime.Spec.Template, trainingRuntime.Spec.MLPolicy, trainingRuntime.Spec.PodGroupPolicy)
+	return &trainingRuntime.Spec, trainingRuntime.Generation, nil
 }
 
 func (r *TrainingRuntime) buildObjects(
 	ctx context.Context, trainJob *trainer.TrainJob, jobSetTemplateSpec trainer.JobSetTemplateSpec, mlPolicy *trainer.MLPolicy, podGroupPolicy *trainer.PodGroupPolicy,
-) ([]any, error) {
-
+) (objs []any, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "BuildObjects", trace.WithAttributes(tracing.TrainJobAttributes(trainJob)...))
+	defer func() {
+		span.SetAttributes(tracing.ObjectsCountKey.Int(len(objs)))
+		tracing.EndSpan(span, err)
+	}()
 	info, err := r.newRuntimeInfo(trainJob, jobSetTemplateSpec, mlPolicy, podGroupPolicy)
 	if err != nil {
 		return nil, err
 	}
-	if err = r.framework.RunEnforceMLPolicyPlugins(info, trainJob); err != nil {
+	// The Pod network is identified before enforcing the MLPolicy so that the MLPolicy plugins
+	// (e.g., TensorFlow TF_CONFIG) can use the PodSet endpoints.
+	// The endpoints are lazily evaluated from the PodSet count when iterated,
+	// so the NumNodes overridden by the MLPolicy plugins are still reflected in them.
+	if err = r.framework.RunPodNetworkPlugins(ctx, info, trainJob); err != nil {
 		return nil, err
 	}
 
-	if err = r.framework.RunEnforcePodGroupPolicyPlugins(info, trainJob); err != nil {
+	if err = r.framework.RunEnforceMLPolicyPlugins(ctx, info, trainJob); err != nil {
 		return nil, err
 	}
 
-	if err = r.framework.RunPodNetworkPlugins(info, trainJob); err != nil {
+	if err = r.framework.RunEnforcePodGroupPolicyPlugins(ctx, info, trainJob); err != nil {
 		return nil, err
 	}
-
+	span.SetAttributes(tracing.PodSetsCountKey.Int(len(info.TemplateSpec.PodSets)))
+	for _, ps := range info.TemplateSpec.PodSets {
+		metrics.ReportPodSetNodes(trainJob, ps.Name, ptr.Deref(ps.Count, 1))
+	}
 	return r.framework.RunComponentBuilderPlugins(ctx, info, trainJob)
 }
 
@@ -155,14 +180,13 @@ func (r *TrainingRuntime) newRuntimeInfo(
 	}
 
 	for i, rJob := range jobSetSpecApply.ReplicatedJobs {
-		// TODO: Support multiple replicas ('.template.spec.replicatedJobs[*].replicas') for replicated Jobs.
-		// REF: https://github.com/kubeflow/trainer/issues/2318
-		count := ptr.Deref(rJob.Template.Spec.Parallelism, 1)
+		replicas := max(ptr.Deref(rJob.Replicas, constants.DefaultJobReplicas), constants.DefaultJobReplicas)
+		count := replicas * ptr.Deref(rJob.Template.Spec.Parallelism, 1)
 		var ancestor *string
 		if metadata := rJob.Template.ObjectMetaApplyConfiguration; metadata != nil && metadata.Labels != nil {
 			if labelAncestor, ok := metadata.Labels[constants.LabelTrainJobAncestor]; ok {
-				if labelAncestor == constants.AncestorTrainer && mlPolicy != nil {
-					count = ptr.Deref(mlPolicy.NumNodes, 1)
+				if labelAncestor == constants.AncestorTrainer && mlPolicy != nil && mlPolicy.NumNodes != nil {
+					count = *mlPolicy.NumNodes
 				}
 				ancestor = &labelAncestor
 			}
@@ -170,6 +194,7 @@ func (r *TrainingRuntime) newRuntimeInfo(
 		opts = append(opts, runtime.WithPodSet(
 			*rJob.Name,
 			ancestor,
+			replicas,
 			count,
 			*jobSetTemplateSpec.Spec.ReplicatedJobs[i].Template.Spec.Template.Spec.DeepCopy(),
 			rJob.Template.Spec.Template.Spec),
@@ -216,9 +241,12 @@ func syncPodSets(info *runtime.Info) {
 		return
 	}
 	for psIdx, ps := range info.TemplateSpec.PodSets {
+		if ps.Replicas != nil {
+			jsSpec.ReplicatedJobs[psIdx].Replicas = ps.Replicas
+		}
 		if ps.Count != nil {
-			jsSpec.ReplicatedJobs[psIdx].Template.Spec.Parallelism = ps.Count
-			jsSpec.ReplicatedJobs[psIdx].Template.Spec.Completions = ps.Count
+			jsSpec.ReplicatedJobs[psIdx].Template.Spec.Parallelism = ptr.To(ps.Parallelism())
+			jsSpec.ReplicatedJobs[psIdx].Template.Spec.Completions = ptr.To(ps.Parallelism())
 		}
 		apply.UpsertVolumes(&jsSpec.ReplicatedJobs[psIdx].Template.Spec.Template.Spec.Volumes, ps.Volumes...)
 		for containerIdx, container := range ps.Containers {
@@ -242,6 +270,14 @@ func (r *TrainingRuntime) TerminalCondition(ctx context.Context, trainJob *train
 	return r.framework.RunTerminalConditionPlugins(ctx, trainJob)
 }
 
+func (r *TrainingRuntime) RunningCondition(ctx context.Context, trainJob *trainer.TrainJob) (*metav1.Condition, error) {
+	return r.framework.RunRunningConditionPlugins(ctx, trainJob)
+}
+
+func (r *TrainingRuntime) JobsStatus(ctx context.Context, trainJob *trainer.TrainJob) ([]trainer.JobStatus, *int32, error) {
+	return r.framework.RunJobsStatusPlugins(ctx, trainJob)
+}
+
 func (r *TrainingRuntime) EventHandlerRegistrars() []runtime.ReconcilerBuilder {
 	var builders []runtime.ReconcilerBuilder
 	for _, ex := range r.framework.WatchExtensionPlugins() {
@@ -251,16 +287,84 @@ func (r *TrainingRuntime) EventHandlerRegistrars() []runtime.ReconcilerBuilder {
 }
 
 func (r *TrainingRuntime) ValidateObjects(ctx context.Context, old, new *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
-	trainingRuntime := &trainer.TrainingRuntime{}
-	if err := r.client.Get(ctx, client.ObjectKey{
-		Namespace: new.Namespace,
-		Name:      new.Spec.RuntimeRef.Name,
-	}, trainingRuntime); err != nil {
+	// The TrainJob is validated against the runtime snapshot pinned to it when it exists.
+	spec, err := r.runtimeSpec(ctx, new, false, r.latestRuntimeSpec)
+	if err != nil {
 		return nil, field.ErrorList{
 			field.Invalid(field.NewPath("spec", "runtimeRef"), new.Spec.RuntimeRef,
 				fmt.Sprintf("%v: specified trainingRuntime must be created before the TrainJob is created", err)),
 		}
 	}
-	info, _ := r.newRuntimeInfo(new, trainingRuntime.Spec.Template, trainingRuntime.Spec.MLPolicy, trainingRuntime.Spec.PodGroupPolicy) // ignoring the error here as the runtime configured should be valid
-	return r.framework.RunCustomValidationPlugins(ctx, info, old, new)
+	info, _ := r.newRuntimeInfo(new, spec.Template, spec.MLPolicy, spec.PodGroupPolicy) // ignoring the error here as the runtime configured should be valid
+	warnings, allErrs := validateDeprecation(old, new, trainer.TrainingRuntimeKind, spec.Deprecation, time.Now())
+	allErrs = append(allErrs, r.validatePodGroupPolicyPlugin(old, spec.PodGroupPolicy)...)
+	pluginWarnings, errs := r.framework.RunCustomValidationPlugins(ctx, info, old, new)
+	return append(warnings, pluginWarnings...), append(allErrs, errs...)
+}
+
+// validateDeprecation warns the new TrainJob referencing the deprecated runtime,
+// and rejects it once the rejectAfter time has passed.
+// The existing TrainJobs are not affected by the deprecation.
+func validateDeprecation(old, new *trainer.TrainJob, kind string, deprecation *trainer.RuntimeDeprecation, now time.Time) (admission.Warnings, field.ErrorList) {
+	if old != nil || deprecation == nil {
+		return nil, nil
+	}
+	message := runtime.DeprecationMessage(kind, new.Spec.RuntimeRef.Name, deprecation)
+	if deprecation.RejectAfter != nil && now.After(deprecation.RejectAfter.Time) {
+		return nil, field.ErrorList{
+			field.Forbidden(field.NewPath("spec", "runtimeRef"), message),
+		}
+	}
+	return admission.Warnings{message}, nil
+}
+
+func (r *TrainingRuntime) ValidateRuntime(ctx context.Context, spec *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList) {
+	warnings, errs := r.framework.RunRuntimeValidationPlugins(ctx, spec)
+	if name := r.disabledPodGroupPolicyPlugin(spec.PodGroupPolicy); len(name) != 0 {
+		errs = append(errs, field.Forbidden(field.NewPath("spec", "podGroupPolicy"),
+			fmt.Sprintf("the %s plugin must be enabled in the Configuration", name)))
+	}
+	return warnings, errs
+}
+
+// disabledPodGroupPolicyPlugin returns the name of the plugin for the PodGroupPolicy
+// when it is not enabled in the Configuration, otherwise it returns an empty string.
+func (r *TrainingRuntime) disabledPodGroupPolicyPlugin(podGroupPolicy *trainer.PodGroupPolicy) string {
+	switch {
+	case podGroupPolicy == nil:
+		return ""
+	case podGroupPolicy.Coscheduling != nil && !r.framework.PluginEnabled(coscheduling.Name):
+		return coscheduling.Name
+	case podGroupPolicy.Volcano != nil && !r.framework.PluginEnabled(volcano.Name):
+		return volcano.Name
+	}
+	return ""
+}
+
+// validatePodGroupPolicyPlugin rejects the new TrainJob whose runtime has the PodGroupPolicy
+// for the disabled plugin since the gang-scheduling would be silently ignored.
+func (r *TrainingRuntime) validatePodGroupPolicyPlugin(old *trainer.TrainJob, podGroupPolicy *trainer.PodGroupPolicy) field.ErrorList {
+	if old != nil {
+		return nil
+	}
+	if name := r.disabledPodGroupPolicyPlugin(podGroupPolicy); len(name) != 0 {
+		return field.ErrorList{
+			field.Forbidden(field.NewPath("spec", "runtimeRef"),
+				fmt.Sprintf("the runtime has the podGroupPolicy for the %s plugin which is not enabled in the Configuration", name)),
+		}
+	}
+	return nil
+}
+
+func (r *TrainingRuntime) DefaultObjects(ctx context.Context, trainJob *trainer.TrainJob) error {
+	spec, err := r.runtimeSpec(ctx, trainJob, false, r.latestRuntimeSpec)
+	if err != nil {
+		// The missing trainingRuntime is reported by the validating webhook.
+		return client.IgnoreNotFound(err)
+	}
+	info, err := r.newRuntimeInfo(trainJob, spec.Template, spec.MLPolicy, spec.PodGroupPolicy)
+	if err != nil {
+		return err
+	}
+	return r.framework.RunCustomDefaultingPlugins(ctx, info, trainJob)
 }
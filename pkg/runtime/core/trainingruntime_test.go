@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -28,13 +29,18 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2/ktesting"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	fwkplugins "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/coscheduling"
 	jobsetplgconsts "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset/constants"
 	testingutil "github.com/kubeflow/trainer/v2/pkg/util/testing"
 )
@@ -108,6 +114,156 @@ func TestTrainingRuntimeNewObjects(t *testing.T) {
 					Obj(),
 			},
 		},
+		"succeeded to build PodGroup and JobSet with NumNodes distributed across multiple trainer replicas.": {
+			trainingRuntime: testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").Spec).
+						WithMLPolicy(
+							testingutil.MakeMLPolicyWrapper().
+								WithNumNodes(100).
+								Obj(),
+						).
+						PodGroupPolicyCoschedulingSchedulingTimeout(120).
+						Replicas(2, constants.Node).
+						Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+						Container(constants.ModelInitializer, constants.ModelInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+						Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+						Obj(),
+				).Obj(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Suspend(true).
+				UID("uid").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "test-runtime").
+				Trainer(
+					testingutil.MakeTrainJobTrainerWrapper().
+						NumNodes(30).
+						Obj(),
+				).
+				Obj(),
+			wantObjs: []runtime.Object{
+				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
+					Suspend(true).
+					PodLabel(schedulerpluginsv1alpha1.PodGroupLabel, "test-job").
+					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
+					Replicas(2, constants.Node).
+					Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
+					Completions(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
+					NumNodes(15).
+					Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					Container(constants.ModelInitializer, constants.ModelInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					Obj(),
+				testingutil.MakeSchedulerPluginsPodGroup(metav1.NamespaceDefault, "test-job").
+					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
+					MinMember(32). // 32 replicas = 2 x 15 Trainer nodes + 2 Initializer.
+					MinResources(corev1.ResourceList{
+						// Trainer node has 30 CPUs + 2 CPUs from 2 initializer containers.
+						corev1.ResourceCPU: resource.MustParse("32"),
+					}).
+					SchedulingTimeout(120).
+					Obj(),
+			},
+		},
+		"succeeded to build PodGroup and JobSet with trainer replicas when neither Runtime nor TrainJob sets NumNodes.": {
+			trainingRuntime: testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").Spec).
+						WithMLPolicy(
+							testingutil.MakeMLPolicyWrapper().
+								Obj(),
+						).
+						PodGroupPolicyCoschedulingSchedulingTimeout(120).
+						Replicas(2, constants.Node).
+						Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+						Container(constants.ModelInitializer, constants.ModelInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+						Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+						Obj(),
+				).Obj(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Suspend(true).
+				UID("uid").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "test-runtime").
+				Obj(),
+			wantObjs: []runtime.Object{
+				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
+					Suspend(true).
+					PodLabel(schedulerpluginsv1alpha1.PodGroupLabel, "test-job").
+					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
+					Replicas(2, constants.Node).
+					Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
+					Completions(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
+					NumNodes(1).
+					Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					Container(constants.ModelInitializer, constants.ModelInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					Obj(),
+				testingutil.MakeSchedulerPluginsPodGroup(metav1.NamespaceDefault, "test-job").
+					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
+					MinMember(4). // 4 replicas = 2 x 1 Trainer nodes + 2 Initializer.
+					MinResources(corev1.ResourceList{
+						// Trainer node has 2 CPUs + 2 CPUs from 2 initializer containers.
+						corev1.ResourceCPU: resource.MustParse("4"),
+					}).
+					SchedulingTimeout(120).
+					Obj(),
+			},
+		},
+		"succeeded to build Volcano PodGroup and JobSet with NumNodes from the TrainJob.": {
+			trainingRuntime: testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").Spec).
+						WithMLPolicy(
+							testingutil.MakeMLPolicyWrapper().
+								WithNumNodes(100).
+								Obj(),
+						).
+						PodGroupPolicyVolcano(&trainer.VolcanoPodGroupPolicySource{
+							Queue:             ptr.To("research"),
+							PriorityClassName: ptr.To("high-priority"),
+						}).
+						Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+						Container(constants.ModelInitializer, constants.ModelInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+						Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+						Obj(),
+				).Obj(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				Suspend(true).
+				UID("uid").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "test-runtime").
+				Trainer(
+					testingutil.MakeTrainJobTrainerWrapper().
+						NumNodes(30).
+						Obj(),
+				).
+				Obj(),
+			wantObjs: []runtime.Object{
+				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
+					Suspend(true).
+					PodAnnotation(volcanov1beta1.KubeGroupNameAnnotationKey, "test-job").
+					PodAnnotation(volcanov1beta1.QueueNameAnnotationKey, "research").
+					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node, constants.Launcher).
+					Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
+					Completions(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
+					NumNodes(30).
+					Container(constants.DatasetInitializer, constants.DatasetInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					Container(constants.ModelInitializer, constants.ModelInitializer, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					Obj(),
+				testingutil.MakeVolcanoPodGroup(metav1.NamespaceDefault, "test-job").
+					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
+					MinMember(32). // 32 replicas = 30 Trainer nodes + 2 Initializer.
+					MinResources(corev1.ResourceList{
+						// Trainer node has 30 CPUs + 2 CPUs from 2 initializer containers.
+						corev1.ResourceCPU: resource.MustParse("32"),
+					}).
+					Queue("research").
+					PriorityClassName("high-priority").
+					Obj(),
+			},
+		},
 		"succeeded to build JobSet with NumNodes from the Runtime and container from the TrainJob.": {
 			trainingRuntime: testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").RuntimeSpec(
 				testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").Spec).
@@ -1283,6 +1439,59 @@ test-job-node-0-1.test-job slots=8
 					Obj(),
 			},
 		},
+		"succeeded to build JobSet with TensorFlow cluster spec reflecting NumNodes from the TrainJob": {
+			trainingRuntime: testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").RuntimeSpec(
+				testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").Spec).
+					WithMLPolicy(
+						testingutil.MakeMLPolicyWrapper().
+							WithNumNodes(1).
+							WithMLPolicySource(*testingutil.MakeMLPolicySourceWrapper().
+								TensorFlowPolicy().
+								Obj(),
+							).
+							Obj(),
+					).
+					Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					Obj(),
+			).Obj(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+				UID("uid").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "test-runtime").
+				Trainer(
+					testingutil.MakeTrainJobTrainerWrapper().
+						NumNodes(2).
+						Obj(),
+				).
+				Obj(),
+			wantObjs: []runtime.Object{
+				testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "test-job").
+					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "test-job", "uid").
+					Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node, constants.Launcher).
+					Parallelism(1, constants.DatasetInitializer, constants.ModelInitializer).
+					Completions(1, constants.DatasetInitializer, constants.ModelInitializer).
+					NumNodes(2).
+					Container(constants.Node, constants.Node, "test:runtime", []string{"runtime"}, []string{"runtime"}, resRequests).
+					ContainerTrainerPorts([]corev1.ContainerPort{{ContainerPort: constants.ContainerTrainerPort}}).
+					Env(constants.Node, constants.Node,
+						[]corev1.EnvVar{
+							{
+								Name: constants.TensorFlowEnvTaskIndex,
+								ValueFrom: &corev1.EnvVarSource{
+									FieldRef: &corev1.ObjectFieldSelector{
+										FieldPath: constants.JobCompletionIndexFieldPath,
+									},
+								},
+							},
+							{
+								Name: constants.TensorFlowEnvConfig,
+								Value: fmt.Sprintf(`{"cluster":{"worker":["test-job-node-0-0.test-job:%[1]d","test-job-node-0-1.test-job:%[1]d"]},"task":{"type":"worker","index":$(%[2]s)}}`,
+									constants.ContainerTrainerPort, constants.TensorFlowEnvTaskIndex),
+							},
+						}...,
+					).
+					Obj(),
+			},
+		},
 		// Failed test cases.
 		"missing trainingRuntime resource": {
 			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job-3").
@@ -1325,7 +1534,7 @@ test-job-node-0-1.test-job slots=8
 			}
 			c := clientBuilder.Build()
 
-			trainingRuntime, err := NewTrainingRuntime(ctx, c, testingutil.AsIndex(clientBuilder))
+			trainingRuntime, err := NewTrainingRuntime(ctx, c, testingutil.AsIndex(clientBuilder), fwkplugins.NewRegistry())
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1346,3 +1555,188 @@ test-job-node-0-1.test-job slots=8
 		})
 	}
 }
+
+func TestTrainingRuntimeValidateObjects(t *testing.T) {
+	past := metav1.NewTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	future := metav1.NewTime(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC))
+	replacedBy := &trainer.RuntimeRef{
+		Name:     "torch-new",
+		APIGroup: ptr.To(trainer.GroupVersion.Group),
+		Kind:     ptr.To(trainer.TrainingRuntimeKind),
+	}
+	trainJob := testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test-job").
+		RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "torch-old").
+		Obj()
+
+	cases := map[string]struct {
+		registry        fwkplugins.Registry
+		trainingRuntime *trainer.TrainingRuntime
+		oldObj          *trainer.TrainJob
+		newObj          *trainer.TrainJob
+		wantWarnings    admission.Warnings
+		wantError       field.ErrorList
+	}{
+		"no warnings when the runtime is not deprecated": {
+			trainingRuntime: testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "torch-old").Obj(),
+			newObj:          trainJob,
+		},
+		"new TrainJob gets the warning with the replacement": {
+			trainingRuntime: testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "torch-old").
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "torch-old").Spec).
+						Deprecation(replacedBy, nil).
+						Obj(),
+				).
+				Obj(),
+			newObj: trainJob,
+			wantWarnings: admission.Warnings{
+				`TrainingRuntime "torch-old" is deprecated, use TrainingRuntime "torch-new" instead`,
+			},
+		},
+		"new TrainJob gets the warning with the rejection time before the runtime is retired": {
+			trainingRuntime: testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "torch-old").
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "torch-old").Spec).
+						Deprecation(replacedBy, &future).
+						Obj(),
+				).
+				Obj(),
+			newObj: trainJob,
+			wantWarnings: admission.Warnings{
+				`TrainingRuntime "torch-old" is deprecated, use TrainingRuntime "torch-new" instead; new TrainJobs are rejected after 2100-01-01T00:00:00Z`,
+			},
+		},
+		"new TrainJob is rejected after the rejectAfter time": {
+			trainingRuntime: testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "torch-old").
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "torch-old").Spec).
+						Deprecation(replacedBy, &past).
+						Obj(),
+				).
+				Obj(),
+			newObj: trainJob,
+			wantError: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "runtimeRef"),
+					`TrainingRuntime "torch-old" is deprecated, use TrainingRuntime "torch-new" instead; new TrainJobs are rejected after 2000-01-01T00:00:00Z`),
+			},
+		},
+		"existing TrainJob is not affected after the rejectAfter time": {
+			trainingRuntime: testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "torch-old").
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "torch-old").Spec).
+						Deprecation(replacedBy, &past).
+						Obj(),
+				).
+				Obj(),
+			oldObj: trainJob,
+			newObj: trainJob,
+		},
+		"new TrainJob is rejected when the runtime has the podGroupPolicy for the disabled plugin": {
+			registry: fwkplugins.NewEnabledRegistry(nil, []string{coscheduling.Name}),
+			trainingRuntime: testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "torch-old").
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "torch-old").Spec).
+						PodGroupPolicyCoschedulingSchedulingTimeout(120).
+						Obj(),
+				).
+				Obj(),
+			newObj: trainJob,
+			wantError: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "runtimeRef"),
+					"the runtime has the podGroupPolicy for the CoScheduling plugin which is not enabled in the Configuration"),
+			},
+		},
+		"existing TrainJob is not affected by the podGroupPolicy for the disabled plugin": {
+			registry: fwkplugins.NewEnabledRegistry(nil, []string{coscheduling.Name}),
+			trainingRuntime: testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "torch-old").
+				RuntimeSpec(
+					testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "torch-old").Spec).
+						PodGroupPolicyCoschedulingSchedulingTimeout(120).
+						Obj(),
+				).
+				Obj(),
+			oldObj: trainJob,
+			newObj: trainJob,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			clientBuilder := testingutil.NewClientBuilder().WithObjects(tc.trainingRuntime)
+			c := clientBuilder.Build()
+
+			registry := tc.registry
+			if registry == nil {
+				registry = fwkplugins.NewRegistry()
+			}
+			trainingRuntime, err := NewTrainingRuntime(ctx, c, testingutil.AsIndex(clientBuilder), registry)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			warnings, errs := trainingRuntime.ValidateObjects(ctx, tc.oldObj, tc.newObj)
+			if diff := cmp.Diff(tc.wantWarnings, warnings, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Unexpected warnings (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantError, errs, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Unexpected errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTrainingRuntimeValidateRuntime(t *testing.T) {
+	cases := map[string]struct {
+		registry  fwkplugins.Registry
+		spec      trainer.TrainingRuntimeSpec
+		wantError field.ErrorList
+	}{
+		"podGroupPolicy for the enabled plugin": {
+			registry: fwkplugins.NewEnabledRegistry(nil, nil),
+			spec: testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").Spec).
+				PodGroupPolicyCoschedulingSchedulingTimeout(120).
+				Obj(),
+		},
+		"podGroupPolicy for the disabled CoScheduling plugin": {
+			registry: fwkplugins.NewEnabledRegistry(nil, []string{coscheduling.Name}),
+			spec: testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").Spec).
+				PodGroupPolicyCoschedulingSchedulingTimeout(120).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "podGroupPolicy"), "the CoScheduling plugin must be enabled in the Configuration"),
+			},
+		},
+		"podGroupPolicy for the disabled Volcano plugin": {
+			registry: fwkplugins.NewEnabledRegistry(nil, nil),
+			spec: testingutil.MakeTrainingRuntimeSpecWrapper(testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test-runtime").Spec).
+				PodGroupPolicyVolcano(&trainer.VolcanoPodGroupPolicySource{}).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "podGroupPolicy"), "the Volcano plugin must be enabled in the Configuration"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			clientBuilder := testingutil.NewClientBuilder()
+			c := clientBuilder.Build()
+
+			trainingRuntime, err := NewTrainingRuntime(ctx, c, testingutil.AsIndex(clientBuilder), tc.registry)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, errs := trainingRuntime.ValidateRuntime(ctx, &tc.spec)
+			if diff := cmp.Diff(tc.wantError, errs, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Unexpected errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,19 +19,27 @@ package core
 import (
 	"context"
 	"errors"
+	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/metrics"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
 	fwkplugins "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins"
+	"github.com/kubeflow/trainer/v2/pkg/tracing"
 )
 
-var errorTooManyTerminalConditionPlugin = errors.New("too many TerminalCondition plugins are registered")
+var (
+	errorTooManyTerminalConditionPlugin = errors.New("too many TerminalCondition plugins are registered")
+	errorTooManyRunningConditionPlugin  = errors.New("too many RunningCondition plugins are registered")
+	errorTooManyJobsStatusPlugin        = errors.New("too many JobsStatus plugins are registered")
+)
 
 type Framework struct {
 	registry                     fwkplugins.Registry
@@ -39,10 +47,14 @@ type Framework struct {
 	enforceMLPlugins             []framework.EnforceMLPolicyPlugin
 	enforcePodGroupPolicyPlugins []framework.EnforcePodGroupPolicyPlugin
 	customValidationPlugins      []framework.CustomValidationPlugin
+	runtimeValidationPlugins     []framework.RuntimeValidationPlugin
+	customDefaultingPlugins      []framework.CustomDefaultingPlugin
 	watchExtensionPlugins        []framework.WatchExtensionPlugin
 	podNetworkPlugins            []framework.PodNetworkPlugin
 	componentBuilderPlugins      []framework.ComponentBuilderPlugin
 	terminalConditionPlugins     []framework.TerminalConditionPlugin
+	runningConditionPlugins      []framework.RunningConditionPlugin
+	jobsStatusPlugins            []framework.JobsStatusPlugin
 }
 
 func New(ctx context.Context, c client.Client, r fwkplugins.Registry, indexer client.FieldIndexer) (*Framework, error) {
@@ -66,6 +78,12 @@ func New(ctx context.Context, c client.Client, r fwkplugins.Registry, indexer cl
 		if p, ok := plugin.(framework.CustomValidationPlugin); ok {
 			f.customValidationPlugins = append(f.customValidationPlugins, p)
 		}
+		if p, ok := plugin.(framework.RuntimeValidationPlugin); ok {
+			f.runtimeValidationPlugins = append(f.runtimeValidationPlugins, p)
+		}
+		if p, ok := plugin.(framework.CustomDefaultingPlugin); ok {
+			f.customDefaultingPlugins = append(f.customDefaultingPlugins, p)
+		}
 		if p, ok := plugin.(framework.WatchExtensionPlugin); ok {
 			f.watchExtensionPlugins = append(f.watchExtensionPlugins, p)
 		}
@@ -78,23 +96,39 @@ func New(ctx context.Context, c client.Client, r fwkplugins.Registry, indexer cl
 		if p, ok := plugin.(framework.TerminalConditionPlugin); ok {
 			f.terminalConditionPlugins = append(f.terminalConditionPlugins, p)
 		}
+		if p, ok := plugin.(framework.RunningConditionPlugin); ok {
+			f.runningConditionPlugins = append(f.runningConditionPlugins, p)
+		}
+		if p, ok := plugin.(framework.JobsStatusPlugin); ok {
+			f.jobsStatusPlugins = append(f.jobsStatusPlugins, p)
+		}
 	}
 	f.plugins = plugins
 	return f, nil
 }
 
-func (f *Framework) RunEnforceMLPolicyPlugins(info *runtime.Info, trainJob *trainer.TrainJob) error {
+func (f *Framework) RunEnforceMLPolicyPlugins(ctx context.Context, info *runtime.Info, trainJob *trainer.TrainJob) (err error) {
+	ctx, phaseSpan := startPhaseSpan(ctx, "EnforceMLPolicy", trainJob)
+	defer func() { tracing.EndSpan(phaseSpan, err) }()
 	for _, plugin := range f.enforceMLPlugins {
-		if err := plugin.EnforceMLPolicy(info, trainJob); err != nil {
+		_, span := startPluginSpan(ctx, "EnforceMLPolicy", plugin.Name())
+		err = plugin.EnforceMLPolicy(info, trainJob)
+		tracing.EndSpan(span, err)
+		if err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (f *Framework) RunEnforcePodGroupPolicyPlugins(info *runtime.Info, trainJob *trainer.TrainJob) error {
+func (f *Framework) RunEnforcePodGroupPolicyPlugins(ctx context.Context, info *runtime.Info, trainJob *trainer.TrainJob) (err error) {
+	ctx, phaseSpan := startPhaseSpan(ctx, "EnforcePodGroupPolicy", trainJob)
+	defer func() { tracing.EndSpan(phaseSpan, err) }()
 	for _, plugin := range f.enforcePodGroupPolicyPlugins {
-		if err := plugin.EnforcePodGroupPolicy(info, trainJob); err != nil {
+		_, span := startPluginSpan(ctx, "EnforcePodGroupPolicy", plugin.Name())
+		err = plugin.EnforcePodGroupPolicy(info, trainJob)
+		tracing.EndSpan(span, err)
+		if err != nil {
 			return err
 		}
 	}
@@ -116,21 +150,59 @@ func (f *Framework) RunCustomValidationPlugins(ctx context.Context, info *runtim
 	return aggregatedWarnings, aggregatedErrors
 }
 
-func (f *Framework) RunPodNetworkPlugins(info *runtime.Info, trainJob *trainer.TrainJob) error {
+func (f *Framework) RunRuntimeValidationPlugins(ctx context.Context, spec *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList) {
+	var aggregatedWarnings admission.Warnings
+	var aggregatedErrors field.ErrorList
+	for _, plugin := range f.runtimeValidationPlugins {
+		warnings, errs := plugin.ValidateRuntime(ctx, spec)
+		if len(warnings) != 0 {
+			aggregatedWarnings = append(aggregatedWarnings, warnings...)
+		}
+		if errs != nil {
+			aggregatedErrors = append(aggregatedErrors, errs...)
+		}
+	}
+	return aggregatedWarnings, aggregatedErrors
+}
+
+func (f *Framework) RunCustomDefaultingPlugins(ctx context.Context, info *runtime.Info, trainJob *trainer.TrainJob) error {
+	for _, plugin := range f.customDefaultingPlugins {
+		if err := plugin.Default(ctx, info, trainJob); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *Framework) RunPodNetworkPlugins(ctx context.Context, info *runtime.Info, trainJob *trainer.TrainJob) (err error) {
+	ctx, phaseSpan := startPhaseSpan(ctx, "PodNetwork", trainJob)
+	defer func() { tracing.EndSpan(phaseSpan, err) }()
 	for _, plugin := range f.podNetworkPlugins {
-		if err := plugin.IdentifyPodNetwork(info, trainJob); err != nil {
+		_, span := startPluginSpan(ctx, "PodNetwork", plugin.Name())
+		err = plugin.IdentifyPodNetwork(info, trainJob)
+		tracing.EndSpan(span, err)
+		if err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (f *Framework) RunComponentBuilderPlugins(ctx context.Context, info *runtime.Info, trainJob *trainer.TrainJob) ([]any, error) {
-	var objs []any
+func (f *Framework) RunComponentBuilderPlugins(ctx context.Context, info *runtime.Info, trainJob *trainer.TrainJob) (objs []any, err error) {
+	ctx, phaseSpan := startPhaseSpan(ctx, "ComponentBuilder", trainJob)
+	defer func() {
+		phaseSpan.SetAttributes(tracing.ObjectsCountKey.Int(len(objs)))
+		tracing.EndSpan(phaseSpan, err)
+	}()
 	for _, plugin := range f.componentBuilderPlugins {
-		components, err := plugin.Build(ctx, info, trainJob)
-		if err != nil {
-			return nil, err
+		pluginCtx, span := startPluginSpan(ctx, "ComponentBuilder", plugin.Name())
+		start := time.Now()
+		components, buildErr := plugin.Build(pluginCtx, info, trainJob)
+		metrics.ComponentBuildDuration(plugin.Name(), time.Since(start))
+		span.SetAttributes(tracing.ObjectsCountKey.Int(len(components)))
+		tracing.EndSpan(span, buildErr)
+		if buildErr != nil {
+			return nil, buildErr
 		}
 		objs = append(objs, components...)
 	}
@@ -148,6 +220,42 @@ func (f *Framework) RunTerminalConditionPlugins(ctx context.Context, trainJob *t
 	return nil, nil
 }
 
+func (f *Framework) RunRunningConditionPlugins(ctx context.Context, trainJob *trainer.TrainJob) (*metav1.Condition, error) {
+	if len(f.runningConditionPlugins) > 1 {
+		return nil, errorTooManyRunningConditionPlugin
+	}
+	if len(f.runningConditionPlugins) != 0 {
+		return f.runningConditionPlugins[0].RunningCondition(ctx, trainJob)
+	}
+	return nil, nil
+}
+
+func (f *Framework) RunJobsStatusPlugins(ctx context.Context, trainJob *trainer.TrainJob) ([]trainer.JobStatus, *int32, error) {
+	if len(f.jobsStatusPlugins) > 1 {
+		return nil, nil, errorTooManyJobsStatusPlugin
+	}
+	if len(f.jobsStatusPlugins) != 0 {
+		return f.jobsStatusPlugins[0].JobsStatus(ctx, trainJob)
+	}
+	return nil, nil, nil
+}
+
+// startPhaseSpan starts the span for the framework extension point.
+func startPhaseSpan(ctx context.Context, phase string, trainJob *trainer.TrainJob) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, phase, trace.WithAttributes(tracing.TrainJobAttributes(trainJob)...))
+}
+
+// startPluginSpan starts the span for the plugin executed in the framework extension point.
+func startPluginSpan(ctx context.Context, phase, plugin string) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, phase+"/"+plugin, trace.WithAttributes(tracing.PluginKey.String(plugin)))
+}
+
+// PluginEnabled returns true when the plugin is registered in the Framework.
+func (f *Framework) PluginEnabled(name string) bool {
+	_, ok := f.plugins[name]
+	return ok
+}
+
 func (f *Framework) WatchExtensionPlugins() []framework.WatchExtensionPlugin {
 	return f.watchExtensionPlugins
 }
@@ -46,11 +46,16 @@ import (
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
 	fwkplugins "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/coscheduling"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/deepspeed"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jax"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset"
 	jobsetplgconsts "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/mpi"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/plainml"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/tensorflow"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/torch"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/volcano"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/xgboost"
 	testingutil "github.com/kubeflow/trainer/v2/pkg/util/testing"
 )
 
@@ -76,24 +81,50 @@ func TestNew(t *testing.T) {
 					plainml.Name:      &plainml.PlainML{},
 					torch.Name:        &torch.Torch{},
 					jobset.Name:       &jobset.JobSet{},
+					jax.Name:          &jax.JAX{},
+					tensorflow.Name:   &tensorflow.TensorFlow{},
+					xgboost.Name:      &xgboost.XGBoost{},
+					deepspeed.Name:    &deepspeed.DeepSpeed{},
+					volcano.Name:      &volcano.Volcano{},
 				},
 				enforceMLPlugins: []framework.EnforceMLPolicyPlugin{
 					&mpi.MPI{},
 					&plainml.PlainML{},
 					&torch.Torch{},
+					&jax.JAX{},
+					&tensorflow.TensorFlow{},
+					&xgboost.XGBoost{},
+					&deepspeed.DeepSpeed{},
 				},
 				enforcePodGroupPolicyPlugins: []framework.EnforcePodGroupPolicyPlugin{
 					&coscheduling.CoScheduling{},
+					&volcano.Volcano{},
 				},
 				customValidationPlugins: []framework.CustomValidationPlugin{
 					&mpi.MPI{},
 					&torch.Torch{},
 					&jobset.JobSet{},
+					&jax.JAX{},
+					&tensorflow.TensorFlow{},
+					&xgboost.XGBoost{},
+					&deepspeed.DeepSpeed{},
+				},
+				runtimeValidationPlugins: []framework.RuntimeValidationPlugin{
+					&coscheduling.CoScheduling{},
+					&mpi.MPI{},
+					&torch.Torch{},
+					&jax.JAX{},
+					&tensorflow.TensorFlow{},
+					&xgboost.XGBoost{},
+					&deepspeed.DeepSpeed{},
+					&volcano.Volcano{},
 				},
 				watchExtensionPlugins: []framework.WatchExtensionPlugin{
 					&coscheduling.CoScheduling{},
 					&jobset.JobSet{},
 					&mpi.MPI{},
+					&deepspeed.DeepSpeed{},
+					&volcano.Volcano{},
 				},
 				podNetworkPlugins: []framework.PodNetworkPlugin{
 					&jobset.JobSet{},
@@ -102,10 +133,18 @@ func TestNew(t *testing.T) {
 					&coscheduling.CoScheduling{},
 					&jobset.JobSet{},
 					&mpi.MPI{},
+					&deepspeed.DeepSpeed{},
+					&volcano.Volcano{},
 				},
 				terminalConditionPlugins: []framework.TerminalConditionPlugin{
 					&jobset.JobSet{},
 				},
+				runningConditionPlugins: []framework.RunningConditionPlugin{
+					&jobset.JobSet{},
+				},
+				jobsStatusPlugins: []framework.JobsStatusPlugin{
+					&jobset.JobSet{},
+				},
 			},
 		},
 		"indexer key for trainingRuntime and runtimeClass is an empty": {
@@ -125,7 +164,7 @@ func TestNew(t *testing.T) {
 	}
 	cmpOpts := []cmp.Option{
 		cmp.AllowUnexported(Framework{}),
-		cmpopts.IgnoreUnexported(coscheduling.CoScheduling{}, mpi.MPI{}, plainml.PlainML{}, torch.Torch{}, jobset.JobSet{}),
+		cmpopts.IgnoreUnexported(coscheduling.CoScheduling{}, mpi.MPI{}, plainml.PlainML{}, torch.Torch{}, jobset.JobSet{}, deepspeed.DeepSpeed{}, volcano.Volcano{}),
 		cmpopts.IgnoreFields(coscheduling.CoScheduling{}, "client"),
 		cmpopts.IgnoreFields(jobset.JobSet{}, "client"),
 		cmpopts.IgnoreTypes(apiruntime.Scheme{}, meta.DefaultRESTMapper{}, fwkplugins.Registry{}),
@@ -175,17 +214,17 @@ func TestRunEnforceMLPolicyPlugins(t *testing.T) {
 			registry: fwkplugins.NewRegistry(),
 			runtimeInfo: runtime.NewInfo(
 				runtime.WithMLPolicySource(testingutil.MakeMLPolicyWrapper().Obj()),
-				runtime.WithPodSet(constants.DatasetInitializer, ptr.To(constants.DatasetInitializer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+				runtime.WithPodSet(constants.DatasetInitializer, ptr.To(constants.DatasetInitializer), 1, 1, corev1.PodSpec{}, corev1ac.PodSpec().
 					WithContainers(
 						corev1ac.Container().WithName(constants.DatasetInitializer),
 					),
 				),
-				runtime.WithPodSet(constants.ModelInitializer, ptr.To(constants.ModelInitializer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+				runtime.WithPodSet(constants.ModelInitializer, ptr.To(constants.ModelInitializer), 1, 1, corev1.PodSpec{}, corev1ac.PodSpec().
 					WithContainers(
 						corev1ac.Container().WithName(constants.ModelInitializer),
 					),
 				),
-				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 10, corev1.PodSpec{}, corev1ac.PodSpec().
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, 10, corev1.PodSpec{}, corev1ac.PodSpec().
 					WithContainers(corev1ac.Container().WithName(constants.Node)),
 				),
 			),
@@ -201,6 +240,7 @@ func TestRunEnforceMLPolicyPlugins(t *testing.T) {
 						{
 							Name:     constants.DatasetInitializer,
 							Ancestor: ptr.To(constants.DatasetInitializer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](1),
 							Containers: []runtime.Container{
 								{
@@ -211,6 +251,7 @@ func TestRunEnforceMLPolicyPlugins(t *testing.T) {
 						{
 							Name:     constants.ModelInitializer,
 							Ancestor: ptr.To(constants.ModelInitializer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](1),
 							Containers: []runtime.Container{
 								{
@@ -221,6 +262,7 @@ func TestRunEnforceMLPolicyPlugins(t *testing.T) {
 						{
 							Name:     constants.Node,
 							Ancestor: ptr.To(constants.AncestorTrainer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](10),
 							Containers: []runtime.Container{{
 								Name: constants.Node,
@@ -237,17 +279,17 @@ func TestRunEnforceMLPolicyPlugins(t *testing.T) {
 				runtime.WithMLPolicySource(
 					testingutil.MakeMLPolicyWrapper().Obj(),
 				),
-				runtime.WithPodSet(constants.DatasetInitializer, ptr.To(constants.DatasetInitializer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+				runtime.WithPodSet(constants.DatasetInitializer, ptr.To(constants.DatasetInitializer), 1, 1, corev1.PodSpec{}, corev1ac.PodSpec().
 					WithContainers(
 						corev1ac.Container().WithName(constants.DatasetInitializer),
 					),
 				),
-				runtime.WithPodSet(constants.ModelInitializer, ptr.To(constants.ModelInitializer), 1, corev1.PodSpec{}, corev1ac.PodSpec().
+				runtime.WithPodSet(constants.ModelInitializer, ptr.To(constants.ModelInitializer), 1, 1, corev1.PodSpec{}, corev1ac.PodSpec().
 					WithContainers(
 						corev1ac.Container().WithName(constants.ModelInitializer),
 					),
 				),
-				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 10, corev1.PodSpec{}, corev1ac.PodSpec().
+				runtime.WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, 10, corev1.PodSpec{}, corev1ac.PodSpec().
 					WithContainers(corev1ac.Container().WithName(constants.Node)),
 				),
 			),
@@ -267,6 +309,7 @@ func TestRunEnforceMLPolicyPlugins(t *testing.T) {
 						{
 							Name:     constants.DatasetInitializer,
 							Ancestor: ptr.To(constants.DatasetInitializer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](1),
 							Containers: []runtime.Container{
 								{
@@ -277,6 +320,7 @@ func TestRunEnforceMLPolicyPlugins(t *testing.T) {
 						{
 							Name:     constants.ModelInitializer,
 							Ancestor: ptr.To(constants.ModelInitializer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](1),
 							Containers: []runtime.Container{
 								{
@@ -287,6 +331,7 @@ func TestRunEnforceMLPolicyPlugins(t *testing.T) {
 						{
 							Name:     constants.Node,
 							Ancestor: ptr.To(constants.AncestorTrainer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](30),
 							Containers: []runtime.Container{{
 								Name: constants.Node,
@@ -316,7 +361,7 @@ func TestRunEnforceMLPolicyPlugins(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			err = fwk.RunEnforceMLPolicyPlugins(tc.runtimeInfo, tc.trainJob)
+			err = fwk.RunEnforceMLPolicyPlugins(ctx, tc.runtimeInfo, tc.trainJob)
 			if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
 				t.Errorf("Unexpected error (-want,+got): %s", diff)
 			}
@@ -383,7 +428,7 @@ func TestRunEnforcePodGroupPolicyPlugins(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			err = fwk.RunEnforcePodGroupPolicyPlugins(tc.runtimeInfo, tc.trainJob)
+			err = fwk.RunEnforcePodGroupPolicyPlugins(ctx, tc.runtimeInfo, tc.trainJob)
 			if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
 				t.Errorf("Unexpected error (-want,+got): %s", diff)
 			}
@@ -444,6 +489,78 @@ func TestRunCustomValidationPlugins(t *testing.T) {
 	}
 }
 
+type fakeCustomDefaultingPlugin struct{}
+
+var _ framework.CustomDefaultingPlugin = (*fakeCustomDefaultingPlugin)(nil)
+
+func newFakeCustomDefaultingPlugin(context.Context, client.Client, client.FieldIndexer) (framework.Plugin, error) {
+	return &fakeCustomDefaultingPlugin{}, nil
+}
+
+const fakeCustomDefaultingPluginName = "fake"
+
+func (f fakeCustomDefaultingPlugin) Name() string { return fakeCustomDefaultingPluginName }
+func (f fakeCustomDefaultingPlugin) Default(_ context.Context, _ *runtime.Info, trainJob *trainer.TrainJob) error {
+	if trainJob.Spec.Suspend == nil {
+		trainJob.Spec.Suspend = ptr.To(true)
+	}
+	return nil
+}
+
+func TestRunCustomDefaultingPlugins(t *testing.T) {
+	cases := map[string]struct {
+		registry     fwkplugins.Registry
+		trainJob     *trainer.TrainJob
+		wantTrainJob *trainer.TrainJob
+		wantError    error
+	}{
+		"there are not any custom defaultings": {
+			registry:     fwkplugins.NewRegistry(),
+			trainJob:     testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").Obj(),
+			wantTrainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").Obj(),
+		},
+		"custom defaulting plugin sets defaults": {
+			registry: fwkplugins.Registry{
+				fakeCustomDefaultingPluginName: newFakeCustomDefaultingPlugin,
+			},
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").Obj(),
+			wantTrainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Suspend(true).
+				Obj(),
+		},
+		"custom defaulting plugin keeps specified values": {
+			registry: fwkplugins.Registry{
+				fakeCustomDefaultingPluginName: newFakeCustomDefaultingPlugin,
+			},
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Suspend(false).
+				Obj(),
+			wantTrainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Suspend(false).
+				Obj(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			clientBuilder := testingutil.NewClientBuilder()
+
+			fwk, err := New(ctx, clientBuilder.Build(), tc.registry, testingutil.AsIndex(clientBuilder))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = fwk.RunCustomDefaultingPlugins(ctx, runtime.NewInfo(), tc.trainJob)
+			if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantTrainJob, tc.trainJob); len(diff) != 0 {
+				t.Errorf("Unexpected TrainJob (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestRunComponentBuilderPlugins(t *testing.T) {
 	cases := map[string]struct {
 		registry        fwkplugins.Registry
@@ -473,6 +590,7 @@ func TestRunComponentBuilderPlugins(t *testing.T) {
 						{
 							Name:     constants.DatasetInitializer,
 							Ancestor: ptr.To(constants.DatasetInitializer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](1),
 							SinglePodRequests: corev1.ResourceList{
 								corev1.ResourceCPU:    resource.MustParse("1"),
@@ -498,6 +616,7 @@ func TestRunComponentBuilderPlugins(t *testing.T) {
 						{
 							Name:     constants.ModelInitializer,
 							Ancestor: ptr.To(constants.ModelInitializer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](1),
 							SinglePodRequests: corev1.ResourceList{
 								corev1.ResourceCPU:    resource.MustParse("1"),
@@ -523,6 +642,7 @@ func TestRunComponentBuilderPlugins(t *testing.T) {
 						{
 							Name:     constants.Node,
 							Ancestor: ptr.To(constants.AncestorTrainer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](1),
 							SinglePodRequests: corev1.ResourceList{
 								corev1.ResourceCPU:    resource.MustParse("1"),
@@ -773,6 +893,7 @@ func TestRunComponentBuilderPlugins(t *testing.T) {
 						{
 							Name:     constants.DatasetInitializer,
 							Ancestor: ptr.To(constants.DatasetInitializer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](1),
 							SinglePodRequests: corev1.ResourceList{
 								corev1.ResourceCPU:    resource.MustParse("1"),
@@ -798,6 +919,7 @@ func TestRunComponentBuilderPlugins(t *testing.T) {
 						{
 							Name:     constants.ModelInitializer,
 							Ancestor: ptr.To(constants.ModelInitializer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](1),
 							SinglePodRequests: corev1.ResourceList{
 								corev1.ResourceCPU:    resource.MustParse("1"),
@@ -823,6 +945,7 @@ func TestRunComponentBuilderPlugins(t *testing.T) {
 						{
 							Name:     constants.Node,
 							Ancestor: ptr.To(constants.AncestorTrainer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](100),
 							SinglePodRequests: corev1.ResourceList{
 								corev1.ResourceCPU:    resource.MustParse("1"),
@@ -888,10 +1011,10 @@ func TestRunComponentBuilderPlugins(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			if err = fwk.RunEnforcePodGroupPolicyPlugins(tc.runtimeInfo, tc.trainJob); err != nil {
+			if err = fwk.RunEnforcePodGroupPolicyPlugins(ctx, tc.runtimeInfo, tc.trainJob); err != nil {
 				t.Fatal(err)
 			}
-			if err = fwk.RunEnforceMLPolicyPlugins(tc.runtimeInfo, tc.trainJob); err != nil {
+			if err = fwk.RunEnforceMLPolicyPlugins(ctx, tc.runtimeInfo, tc.trainJob); err != nil {
 				t.Fatal(err)
 			}
 			objs, err := fwk.RunComponentBuilderPlugins(ctx, tc.runtimeInfo, tc.trainJob)
@@ -921,12 +1044,14 @@ func TestWatchExtensionPlugins(t *testing.T) {
 		registry    fwkplugins.Registry
 		wantPlugins []framework.WatchExtensionPlugin
 	}{
-		"coscheduling, jobset, and mpi are performed": {
+		"coscheduling, jobset, mpi, deepspeed, and volcano are performed": {
 			registry: fwkplugins.NewRegistry(),
 			wantPlugins: []framework.WatchExtensionPlugin{
 				&coscheduling.CoScheduling{},
 				&jobset.JobSet{},
 				&mpi.MPI{},
+				&deepspeed.DeepSpeed{},
+				&volcano.Volcano{},
 			},
 		},
 		"an empty registry": {
@@ -935,7 +1060,7 @@ func TestWatchExtensionPlugins(t *testing.T) {
 	}
 	cmpOpts := []cmp.Option{
 		cmpopts.SortSlices(func(a, b framework.Plugin) bool { return a.Name() < b.Name() }),
-		cmpopts.IgnoreUnexported(coscheduling.CoScheduling{}, jobset.JobSet{}, mpi.MPI{}),
+		cmpopts.IgnoreUnexported(coscheduling.CoScheduling{}, jobset.JobSet{}, mpi.MPI{}, deepspeed.DeepSpeed{}, volcano.Volcano{}),
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -1064,6 +1189,257 @@ func TestTerminalConditionPlugins(t *testing.T) {
 	}
 }
 
+type fakeRunningConditionPlugin struct{}
+
+var _ framework.RunningConditionPlugin = (*fakeRunningConditionPlugin)(nil)
+
+func newFakeRunningConditionPlugin(context.Context, client.Client, client.FieldIndexer) (framework.Plugin, error) {
+	return &fakeRunningConditionPlugin{}, nil
+}
+
+const fakeRunningConditionPluginName = "fake"
+
+func (f fakeRunningConditionPlugin) Name() string { return fakeRunningConditionPluginName }
+func (f fakeRunningConditionPlugin) RunningCondition(context.Context, *trainer.TrainJob) (*metav1.Condition, error) {
+	return nil, nil
+}
+
+func TestRunningConditionPlugins(t *testing.T) {
+	cases := map[string]struct {
+		registry      fwkplugins.Registry
+		trainJob      *trainer.TrainJob
+		jobSet        *jobsetv1alpha2.JobSet
+		wantCondition *metav1.Condition
+		wantError     error
+	}{
+		"jobSet has not been created, yet": {
+			registry: fwkplugins.NewRegistry(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "testing").
+				Obj(),
+		},
+		"jobSet is suspended": {
+			registry: fwkplugins.NewRegistry(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "testing").
+				Obj(),
+			jobSet: testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "testing").
+				Suspend(true).
+				Obj(),
+			wantCondition: &metav1.Condition{
+				Type:    trainer.TrainJobRunning,
+				Status:  metav1.ConditionFalse,
+				Reason:  trainer.TrainJobSuspendedReason,
+				Message: constants.TrainJobRunningSuspendedMessage,
+			},
+		},
+		"trainer pods are not ready": {
+			registry: fwkplugins.NewRegistry(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "testing").
+				Obj(),
+			jobSet: testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "testing").
+				Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
+				ReplicatedJobsStatus(
+					jobsetv1alpha2.ReplicatedJobStatus{
+						Name:      constants.DatasetInitializer,
+						Succeeded: 1,
+					},
+					jobsetv1alpha2.ReplicatedJobStatus{
+						Name:      constants.ModelInitializer,
+						Succeeded: 1,
+					},
+					jobsetv1alpha2.ReplicatedJobStatus{
+						Name:   constants.Node,
+						Active: 1,
+					},
+				).
+				Obj(),
+			wantCondition: &metav1.Condition{
+				Type:    trainer.TrainJobRunning,
+				Status:  metav1.ConditionFalse,
+				Reason:  trainer.TrainJobPodsPendingReason,
+				Message: constants.TrainJobPodsPendingMessage,
+			},
+		},
+		"trainer pods are running": {
+			registry: fwkplugins.NewRegistry(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "testing").
+				Obj(),
+			jobSet: testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "testing").
+				Replicas(1, constants.DatasetInitializer, constants.ModelInitializer, constants.Node).
+				ReplicatedJobsStatus(
+					jobsetv1alpha2.ReplicatedJobStatus{
+						Name:      constants.DatasetInitializer,
+						Succeeded: 1,
+					},
+					jobsetv1alpha2.ReplicatedJobStatus{
+						Name:      constants.ModelInitializer,
+						Succeeded: 1,
+					},
+					jobsetv1alpha2.ReplicatedJobStatus{
+						Name:   constants.Node,
+						Ready:  1,
+						Active: 1,
+					},
+				).
+				Obj(),
+			wantCondition: &metav1.Condition{
+				Type:    trainer.TrainJobRunning,
+				Status:  metav1.ConditionTrue,
+				Reason:  trainer.TrainJobPodsRunningReason,
+				Message: constants.TrainJobPodsRunningMessage,
+			},
+		},
+		"jobSet has been finished": {
+			registry: fwkplugins.NewRegistry(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "testing").
+				Obj(),
+			jobSet: testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "testing").
+				Conditions(metav1.Condition{
+					Type:    string(jobsetv1alpha2.JobSetCompleted),
+					Reason:  jobsetconsts.AllJobsCompletedReason,
+					Message: jobsetconsts.AllJobsCompletedMessage,
+					Status:  metav1.ConditionTrue,
+				}).
+				Obj(),
+			wantCondition: &metav1.Condition{
+				Type:    trainer.TrainJobRunning,
+				Status:  metav1.ConditionFalse,
+				Reason:  trainer.TrainJobFinishedReason,
+				Message: constants.TrainJobFinishedMessage,
+			},
+		},
+		"failed to obtain any running condition due to multiple runningCondition plugin": {
+			registry: fwkplugins.Registry{
+				jobset.Name:                    jobset.New,
+				fakeRunningConditionPluginName: newFakeRunningConditionPlugin,
+			},
+			wantError: errorTooManyRunningConditionPlugin,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			clientBuilder := testingutil.NewClientBuilder()
+			if tc.jobSet != nil {
+				clientBuilder = clientBuilder.WithObjects(tc.jobSet)
+			}
+			c := clientBuilder.Build()
+
+			fwk, err := New(ctx, c, tc.registry, testingutil.AsIndex(clientBuilder))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotCond, gotErr := fwk.RunRunningConditionPlugins(ctx, tc.trainJob)
+			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.wantCondition, gotCond); len(diff) != 0 {
+				t.Errorf("Unexpected running condition (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type fakeJobsStatusPlugin struct{}
+
+var _ framework.JobsStatusPlugin = (*fakeJobsStatusPlugin)(nil)
+
+func newFakeJobsStatusPlugin(context.Context, client.Client, client.FieldIndexer) (framework.Plugin, error) {
+	return &fakeJobsStatusPlugin{}, nil
+}
+
+const fakeJobsStatusPluginName = "fake"
+
+func (f fakeJobsStatusPlugin) Name() string { return fakeJobsStatusPluginName }
+func (f fakeJobsStatusPlugin) JobsStatus(context.Context, *trainer.TrainJob) ([]trainer.JobStatus, *int32, error) {
+	return nil, nil, nil
+}
+
+func TestJobsStatusPlugins(t *testing.T) {
+	cases := map[string]struct {
+		registry       fwkplugins.Registry
+		trainJob       *trainer.TrainJob
+		jobSet         *jobsetv1alpha2.JobSet
+		wantJobsStatus []trainer.JobStatus
+		wantRestarts   *int32
+		wantError      error
+	}{
+		"jobSet has not been created, yet": {
+			registry: fwkplugins.NewRegistry(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "testing").
+				Obj(),
+		},
+		"succeeded to obtain jobs status from jobSet": {
+			registry: fwkplugins.NewRegistry(),
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "testing").
+				Obj(),
+			jobSet: testingutil.MakeJobSetWrapper(metav1.NamespaceDefault, "testing").
+				ReplicatedJobsStatus(
+					jobsetv1alpha2.ReplicatedJobStatus{
+						Name:      constants.DatasetInitializer,
+						Succeeded: 1,
+					},
+					jobsetv1alpha2.ReplicatedJobStatus{
+						Name:   constants.Node,
+						Ready:  1,
+						Active: 1,
+					},
+				).
+				Restarts(2).
+				Obj(),
+			wantJobsStatus: []trainer.JobStatus{
+				{
+					Name:      constants.DatasetInitializer,
+					Succeeded: 1,
+				},
+				{
+					Name:   constants.Node,
+					Ready:  1,
+					Active: 1,
+				},
+			},
+			wantRestarts: ptr.To[int32](2),
+		},
+		"failed to obtain any jobs status due to multiple jobsStatus plugin": {
+			registry: fwkplugins.Registry{
+				jobset.Name:              jobset.New,
+				fakeJobsStatusPluginName: newFakeJobsStatusPlugin,
+			},
+			wantError: errorTooManyJobsStatusPlugin,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			clientBuilder := testingutil.NewClientBuilder()
+			if tc.jobSet != nil {
+				clientBuilder = clientBuilder.WithObjects(tc.jobSet)
+			}
+			c := clientBuilder.Build()
+
+			fwk, err := New(ctx, c, tc.registry, testingutil.AsIndex(clientBuilder))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotJobsStatus, gotRestarts, gotErr := fwk.RunJobsStatusPlugins(ctx, tc.trainJob)
+			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.wantJobsStatus, gotJobsStatus); len(diff) != 0 {
+				t.Errorf("Unexpected jobs status (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRestarts, gotRestarts); len(diff) != 0 {
+				t.Errorf("Unexpected restarts (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestPodNetworkPlugins(t *testing.T) {
 	cases := map[string]struct {
 		registry        fwkplugins.Registry
@@ -1164,7 +1540,7 @@ func TestPodNetworkPlugins(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			err = fwk.RunPodNetworkPlugins(tc.runtimeInfo, tc.trainJob)
+			err = fwk.RunPodNetworkPlugins(ctx, tc.runtimeInfo, tc.trainJob)
 			if diff := cmp.Diff(tc.wantError, err); len(diff) != 0 {
 				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
 			}
@@ -36,6 +36,16 @@ type CustomValidationPlugin interface {
 	Validate(ctx context.Context, info *runtime.Info, oldObj, newObj *trainer.TrainJob) (admission.Warnings, field.ErrorList)
 }
 
+type RuntimeValidationPlugin interface {
+	Plugin
+	ValidateRuntime(ctx context.Context, spec *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList)
+}
+
+type CustomDefaultingPlugin interface {
+	Plugin
+	Default(ctx context.Context, info *runtime.Info, trainJob *trainer.TrainJob) error
+}
+
 type WatchExtensionPlugin interface {
 	Plugin
 	ReconcilerBuilders() []runtime.ReconcilerBuilder
@@ -65,3 +75,14 @@ type TerminalConditionPlugin interface {
 	Plugin
 	TerminalCondition(ctx context.Context, trainJob *trainer.TrainJob) (*metav1.Condition, error)
 }
+
+type RunningConditionPlugin interface {
+	Plugin
+	RunningCondition(ctx context.Context, trainJob *trainer.TrainJob) (*metav1.Condition, error)
+}
+
+type JobsStatusPlugin interface {
+	Plugin
+	// JobsStatus returns the status of the Jobs and the number of times they have been restarted by the failure policy.
+	JobsStatus(ctx context.Context, trainJob *trainer.TrainJob) ([]trainer.JobStatus, *int32, error)
+}
@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -41,6 +42,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
 	schedulerpluginsv1alpha1ac "sigs.k8s.io/scheduler-plugins/pkg/generated/applyconfiguration/scheduling/v1alpha1"
 
@@ -60,6 +63,7 @@ type CoScheduling struct {
 var _ framework.EnforcePodGroupPolicyPlugin = (*CoScheduling)(nil)
 var _ framework.WatchExtensionPlugin = (*CoScheduling)(nil)
 var _ framework.ComponentBuilderPlugin = (*CoScheduling)(nil)
+var _ framework.RuntimeValidationPlugin = (*CoScheduling)(nil)
 
 var (
 	ErrorCanNotSetupTrainingRuntimeRuntimeClassIndexer        = errors.New("setting index on runtimeClass for TrainingRuntime")
@@ -83,6 +87,7 @@ func New(ctx context.Context, client client.Client, indexer client.FieldIndexer)
 		client:     client,
 		restMapper: client.RESTMapper(),
 		scheme:     client.Scheme(),
+		logger:     ctrl.LoggerFrom(ctx),
 	}, nil
 }
 
@@ -90,8 +95,23 @@ func (c *CoScheduling) Name() string {
 	return Name
 }
 
+// ValidateRuntime rejects the JobSet InOrder startupPolicy since JobSet creates the next replicatedJob only once the
+// previous ones are ready, while the Coscheduling PodGroup doesn't schedule any Pods until all members are created.
+func (c *CoScheduling) ValidateRuntime(_ context.Context, spec *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList) {
+	if spec.PodGroupPolicy == nil || spec.PodGroupPolicy.Coscheduling == nil {
+		return nil, nil
+	}
+	if startupPolicy := spec.Template.Spec.StartupPolicy; startupPolicy != nil && startupPolicy.StartupPolicyOrder == jobsetv1alpha2.InOrder {
+		return nil, field.ErrorList{
+			field.Forbidden(field.NewPath("spec", "template", "spec", "startupPolicy", "startupPolicyOrder"),
+				fmt.Sprintf("must not be %s for the Coscheduling podGroupPolicy", jobsetv1alpha2.InOrder)),
+		}
+	}
+	return nil, nil
+}
+
 func (c *CoScheduling) EnforcePodGroupPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
-	if info == nil || info.RuntimePolicy.PodGroupPolicy == nil || trainJob == nil {
+	if info == nil || info.RuntimePolicy.PodGroupPolicy == nil || info.RuntimePolicy.PodGroupPolicy.Coscheduling == nil || trainJob == nil {
 		return nil
 	}
 
@@ -119,18 +139,7 @@ func (c *CoScheduling) Build(ctx context.Context, info *runtime.Info, trainJob *
 		return nil, nil
 	}
 
-	var totalMembers int32
-	totalResources := make(corev1.ResourceList)
-	for _, ps := range info.TemplateSpec.PodSets {
-		count := *ps.Count
-		totalMembers += count
-		for resName, quantity := range ps.SinglePodRequests {
-			quantity.Mul(int64(count))
-			current := totalResources[resName]
-			current.Add(quantity)
-			totalResources[resName] = current
-		}
-	}
+	totalMembers, totalResources := info.TotalPodsAndRequests()
 
 	podGroup := schedulerpluginsv1alpha1ac.PodGroup(trainJob.Name, trainJob.Namespace)
 
@@ -0,0 +1,243 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deepspeed
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/apply"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/mpi"
+)
+
+// DeepSpeed plugin reuses the SSH auth Secret and the hostfile ConfigMap of the MPI plugin.
+// It watches them by itself since the MPI plugin can be disabled.
+type DeepSpeed struct {
+	client client.Client
+}
+
+var _ framework.CustomValidationPlugin = (*DeepSpeed)(nil)
+var _ framework.EnforceMLPolicyPlugin = (*DeepSpeed)(nil)
+var _ framework.WatchExtensionPlugin = (*DeepSpeed)(nil)
+var _ framework.ComponentBuilderPlugin = (*DeepSpeed)(nil)
+var _ framework.RuntimeValidationPlugin = (*DeepSpeed)(nil)
+
+const Name = "DeepSpeed"
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=create;get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;get;list;watch;update;patch
+
+func New(_ context.Context, client client.Client, _ client.FieldIndexer) (framework.Plugin, error) {
+	return &DeepSpeed{
+		client: client,
+	}, nil
+}
+
+func (d *DeepSpeed) Name() string {
+	return Name
+}
+
+func (d *DeepSpeed) ValidateRuntime(_ context.Context, spec *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList) {
+	if spec.MLPolicy == nil || spec.MLPolicy.DeepSpeed == nil {
+		return nil, nil
+	}
+	allErrs := runtime.ValidateReplicatedJob(spec, constants.Launcher, "DeepSpeed")
+	// The launcher runs the training processes as well as the nodes when runLauncherAsNode is enabled.
+	if !ptr.Deref(spec.MLPolicy.DeepSpeed.RunLauncherAsNode, false) {
+		allErrs = append(allErrs, runtime.ValidateReplicatedJob(spec, constants.Node, "DeepSpeed")...)
+	}
+	return nil, allErrs
+}
+
+func (d *DeepSpeed) Validate(_ context.Context, runtimeInfo *runtime.Info, _, newObj *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
+	var allErrs field.ErrorList
+	if runtimeInfo == nil || runtimeInfo.RuntimePolicy.MLPolicySource == nil || runtimeInfo.RuntimePolicy.MLPolicySource.DeepSpeed == nil || newObj.Spec.Trainer == nil {
+		return nil, allErrs
+	}
+	trainerPath := field.NewPath("spec").Child("trainer")
+	if numProcPerNode := newObj.Spec.Trainer.NumProcPerNode; numProcPerNode != nil && numProcPerNode.Type != intstr.Int {
+		allErrs = append(allErrs, field.Invalid(trainerPath.Child("numProcPerNode"), *numProcPerNode, "must have an int value for DeepSpeed TrainJob"))
+	}
+	if ptr.Deref(newObj.Spec.Trainer.NumNodes, 1) >= 2 && ptr.Deref(runtimeInfo.RuntimePolicy.MLPolicySource.DeepSpeed.RunLauncherAsNode, false) {
+		if runtimeInfo.FindPodSetByName(constants.Launcher) == nil || runtimeInfo.FindPodSetByName(constants.Node) == nil {
+			allErrs = append(allErrs, field.Invalid(trainerPath.Child("numNodes"), newObj.Spec.Trainer.NumNodes, "must have 1 when DeepSpeed trainingRuntime with enabled runLauncherAsNode does not have either launcher and node"))
+		}
+	}
+
+	// Check reserved envs.
+	deepSpeedEnvs := sets.New[string]()
+	for _, env := range newObj.Spec.Trainer.Env {
+		if constants.DeepSpeedReservedEnvNames.Has(env.Name) {
+			deepSpeedEnvs.Insert(env.Name)
+		}
+	}
+	if deepSpeedEnvs.Len() > 0 {
+		allErrs = append(allErrs, field.Invalid(trainerPath.Child("env"), newObj.Spec.Trainer.Env, fmt.Sprintf("must not have reserved envs, invalid envs configured: %v", sets.List(deepSpeedEnvs))))
+	}
+	return nil, allErrs
+}
+
+func (d *DeepSpeed) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
+	if info == nil || info.RuntimePolicy.MLPolicySource == nil || info.RuntimePolicy.MLPolicySource.DeepSpeed == nil {
+		return nil
+	}
+	deepSpeedPolicy := info.RuntimePolicy.MLPolicySource.DeepSpeed
+	runLauncherAsNode := ptr.Deref(deepSpeedPolicy.RunLauncherAsNode, false)
+
+	// TrainJob contains the actual information for the Trainer.
+	if trainJob.Spec.Trainer != nil && trainJob.Spec.Trainer.NumNodes != nil {
+		if node := info.FindPodSetByName(constants.Node); node != nil && node.Count != nil {
+			if runLauncherAsNode {
+				// When runLauncherAsNode is enabled, 1 nodes should be allocated to launcher.
+				*node.Count = max(*trainJob.Spec.Trainer.NumNodes-1, 1)
+			} else {
+				*node.Count = *trainJob.Spec.Trainer.NumNodes
+			}
+		}
+	}
+	if trainJob.Spec.Trainer != nil && trainJob.Spec.Trainer.NumProcPerNode != nil {
+		deepSpeedPolicy.NumProcPerNode = ptr.To(int32(trainJob.Spec.Trainer.NumProcPerNode.IntValue()))
+	}
+
+	var numNodes int32
+	for _, ps := range info.TemplateSpec.PodSets {
+		if mpi.IsNode(runLauncherAsNode, ps) {
+			numNodes += ptr.Deref(ps.Count, 1)
+		}
+	}
+
+	// Add Secret and ConfigMap volumes to the Info object.
+	for psIdx, ps := range info.TemplateSpec.PodSets {
+		if ps.Name != constants.Node && ps.Name != constants.Launcher {
+			continue
+		}
+		apply.UpsertVolumes(
+			&info.TemplateSpec.PodSets[psIdx].Volumes,
+			*corev1ac.Volume().
+				WithName(constants.MPISSHAuthVolumeName).
+				WithSecret(corev1ac.SecretVolumeSource().
+					WithSecretName(mpi.SSHAuthSecretName(trainJob.Name)).
+					WithItems(
+						corev1ac.KeyToPath().
+							WithKey(corev1.SSHAuthPrivateKey).
+							WithPath(constants.MPISSHPrivateKeyFile),
+						corev1ac.KeyToPath().
+							WithKey(constants.MPISSHPublicKey).
+							WithPath(constants.MPISSHPublicKeyFile),
+						corev1ac.KeyToPath().
+							WithKey(constants.MPISSHPublicKey).
+							WithPath(constants.MPISSHAuthorizedKeys),
+					),
+				),
+		)
+		if ps.Name == constants.Launcher {
+			apply.UpsertVolumes(
+				&info.TemplateSpec.PodSets[psIdx].Volumes,
+				*corev1ac.Volume().
+					WithName(constants.MPIHostfileVolumeName).
+					WithConfigMap(corev1ac.ConfigMapVolumeSource().
+						WithName(mpi.HostFileConfigMapName(trainJob.Name)).
+						WithItems(
+							corev1ac.KeyToPath().
+								WithKey(constants.MPIHostfileName).
+								WithPath(constants.MPIHostfileName).
+								WithMode(0444),
+						),
+					),
+			)
+		}
+		for cIdx, container := range ps.Containers {
+			if container.Name != constants.Node {
+				continue
+			}
+			apply.UpsertVolumeMounts(
+				&info.TemplateSpec.PodSets[psIdx].Containers[cIdx].VolumeMounts,
+				*corev1ac.VolumeMount().
+					WithName(constants.MPISSHAuthVolumeName).
+					WithMountPath(ptr.Deref(deepSpeedPolicy.SSHAuthMountPath, constants.MPISSHAuthMountPath)),
+			)
+			if ps.Name == constants.Launcher {
+				apply.UpsertVolumeMounts(
+					&info.TemplateSpec.PodSets[psIdx].Containers[cIdx].VolumeMounts,
+					*corev1ac.VolumeMount().
+						WithName(constants.MPIHostfileVolumeName).
+						WithMountPath(constants.MPIHostfileDir),
+				)
+				// The launcher command can use the envs for the deepspeed CLI arguments, e.g.
+				// deepspeed --hostfile $(DEEPSPEED_HOSTFILE) --num_gpus $(DEEPSPEED_NUM_GPUS) train.py
+				apply.UpsertEnvVars(
+					&info.TemplateSpec.PodSets[psIdx].Containers[cIdx].Env,
+					*corev1ac.EnvVar().
+						WithName(constants.DeepSpeedEnvHostfile).
+						WithValue(fmt.Sprintf("%s/%s", constants.MPIHostfileDir, constants.MPIHostfileName)),
+					*corev1ac.EnvVar().
+						WithName(constants.DeepSpeedEnvNumGPUs).
+						WithValue(strconv.Itoa(int(ptr.Deref(deepSpeedPolicy.NumProcPerNode, 1)))),
+					*corev1ac.EnvVar().
+						WithName(constants.DeepSpeedEnvNumNodes).
+						WithValue(strconv.Itoa(int(numNodes))),
+				)
+			}
+		}
+	}
+	info.SyncPodSetsToTemplateSpec()
+	return nil
+}
+
+func (d *DeepSpeed) ReconcilerBuilders() []runtime.ReconcilerBuilder {
+	return mpi.OwnedResourceReconcilerBuilders(d.client)
+}
+
+func (d *DeepSpeed) Build(ctx context.Context, info *runtime.Info, trainJob *trainer.TrainJob) ([]any, error) {
+	if info == nil || info.RuntimePolicy.MLPolicySource == nil || info.RuntimePolicy.MLPolicySource.DeepSpeed == nil {
+		return nil, nil
+	}
+
+	var objects []any
+
+	// SSHAuthSecret is immutable.
+	if err := d.client.Get(ctx, client.ObjectKey{Name: mpi.SSHAuthSecretName(trainJob.Name), Namespace: trainJob.Namespace}, &corev1.Secret{}); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		secret, err := mpi.BuildSSHAuthSecret(trainJob)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build SSH Auth secret: %w", err)
+		}
+		objects = append(objects, secret)
+	}
+	// The DeepSpeed hostfile has the "hostname slots=N" format.
+	hostFile := mpi.BuildHostFileConfigMap(info, trainJob,
+		ptr.Deref(info.RuntimePolicy.MLPolicySource.DeepSpeed.RunLauncherAsNode, false),
+		"%s slots=%d\n",
+		ptr.Deref(info.RuntimePolicy.MLPolicySource.DeepSpeed.NumProcPerNode, 1))
+	return append(objects, hostFile), nil
+}
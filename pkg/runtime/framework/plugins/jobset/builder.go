@@ -17,8 +17,15 @@ limitations under the License.
 package jobset
 
 import (
+	"fmt"
+	"slices"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	batchv1ac "k8s.io/client-go/applyconfigurations/batch/v1"
 	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
 	"k8s.io/utils/ptr"
+	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	jobsetv1alpha2ac "sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
@@ -47,9 +54,6 @@ func (b *Builder) Initializer(trainJob *trainer.TrainJob) *Builder {
 		}
 		// Update values for the Dataset Initializer Job.
 		if ancestor, ok := jobMetadata.Labels[constants.LabelTrainJobAncestor]; ok && ancestor == constants.DatasetInitializer {
-			// TODO: Support multiple replicas ('.template.spec.replicatedJobs[*].replicas') for replicated Jobs.
-			// REF: https://github.com/kubeflow/trainer/issues/2318
-			b.Spec.ReplicatedJobs[i].Replicas = ptr.To[int32](1)
 			for j, container := range rJob.Template.Spec.Template.Spec.Containers {
 				// Update values for the dataset initializer container.
 				if *container.Name == constants.DatasetInitializer && trainJob.Spec.Initializer != nil && trainJob.Spec.Initializer.Dataset != nil {
@@ -73,9 +77,6 @@ func (b *Builder) Initializer(trainJob *trainer.TrainJob) *Builder {
 		}
 		// Update values for the Model Initializer Job.
 		if ancestor, ok := jobMetadata.Labels[constants.LabelTrainJobAncestor]; ok && ancestor == constants.ModelInitializer {
-			// TODO: Support multiple replicas ('.template.spec.replicatedJobs[*].replicas') for replicated Jobs.
-			// REF: https://github.com/kubeflow/trainer/issues/2318
-			b.Spec.ReplicatedJobs[i].Replicas = ptr.To[int32](1)
 			for j, container := range rJob.Template.Spec.Template.Spec.Containers {
 				// Update values for the model initializer container.
 				if *container.Name == constants.ModelInitializer && trainJob.Spec.Initializer != nil && trainJob.Spec.Initializer.Model != nil {
@@ -109,12 +110,11 @@ func (b *Builder) Trainer(info *runtime.Info, trainJob *trainer.TrainJob) *Build
 			continue
 		}
 		if ancestor, ok := jobMetadata.Labels[constants.LabelTrainJobAncestor]; ok && ancestor == constants.AncestorTrainer {
-			// TODO: Support multiple replicas ('.template.spec.replicatedJobs[*].replicas') for replicated Jobs.
-			// REF: https://github.com/kubeflow/trainer/issues/2318
-			b.Spec.ReplicatedJobs[i].Replicas = ptr.To[int32](1)
 			// Update the Parallelism and Completions values for the Trainer Job.
-			b.Spec.ReplicatedJobs[i].Template.Spec.Parallelism = info.FindPodSetByAncestor(constants.AncestorTrainer).Count
-			b.Spec.ReplicatedJobs[i].Template.Spec.Completions = info.FindPodSetByAncestor(constants.AncestorTrainer).Count
+			if ps := info.FindPodSetByAncestor(constants.AncestorTrainer); ps != nil {
+				b.Spec.ReplicatedJobs[i].Template.Spec.Parallelism = ptr.To(ps.Parallelism())
+				b.Spec.ReplicatedJobs[i].Template.Spec.Completions = ptr.To(ps.Parallelism())
+			}
 
 			// Update values for the Trainer container.
 			for j, container := range rJob.Template.Spec.Template.Spec.Containers {
@@ -154,6 +154,18 @@ func (b *Builder) Trainer(info *runtime.Info, trainJob *trainer.TrainJob) *Build
 	return b
 }
 
+// Replicas updates the replicas for all replicated Jobs based on the PodSets.
+func (b *Builder) Replicas(info *runtime.Info) *Builder {
+	for i, rJob := range b.Spec.ReplicatedJobs {
+		replicas := ptr.Deref(rJob.Replicas, constants.DefaultJobReplicas)
+		if ps := info.FindPodSetByName(*rJob.Name); ps != nil {
+			replicas = ptr.Deref(ps.Replicas, replicas)
+		}
+		b.Spec.ReplicatedJobs[i].Replicas = ptr.To(max(replicas, constants.DefaultJobReplicas))
+	}
+	return b
+}
+
 // TODO: Supporting merge labels would be great.
 
 func (b *Builder) PodLabels(labels map[string]string) *Builder {
@@ -163,6 +175,107 @@ func (b *Builder) PodLabels(labels map[string]string) *Builder {
 	return b
 }
 
+func (b *Builder) PodAnnotations(annotations map[string]string) *Builder {
+	if len(annotations) == 0 {
+		return b
+	}
+	for i := range b.Spec.ReplicatedJobs {
+		b.Spec.ReplicatedJobs[i].Template.Spec.Template.WithAnnotations(annotations)
+	}
+	return b
+}
+
+// FailurePolicy translates the TrainJob failure policy into the JobSet FailurePolicy.
+// The rules on the container exit codes and Pod disruptions are enforced by the Job podFailurePolicy,
+// so the targeted Jobs are failed with the PodFailurePolicy reason, or the disrupted Pods are ignored.
+// Since the Job podFailurePolicy requires the Never restartPolicy, those rules without targetJobs
+// are enforced on the trainer node Job only, and the other Jobs, e.g. initializers, keep their restartPolicy.
+func (b *Builder) FailurePolicy(trainJob *trainer.TrainJob) *Builder {
+	if trainJob.Spec.FailurePolicy == nil {
+		return b
+	}
+	failurePolicy := jobsetv1alpha2ac.FailurePolicy().
+		WithMaxRestarts(ptr.Deref(trainJob.Spec.FailurePolicy.MaxRestarts, 0))
+	if b.Spec.FailurePolicy != nil && b.Spec.FailurePolicy.RestartStrategy != nil {
+		failurePolicy.WithRestartStrategy(*b.Spec.FailurePolicy.RestartStrategy)
+	}
+	podFailurePolicyRules := make(map[string][]*batchv1ac.PodFailurePolicyRuleApplyConfiguration, len(b.Spec.ReplicatedJobs))
+	for i, rule := range trainJob.Spec.FailurePolicy.Rules {
+		var podFailurePolicyRule *batchv1ac.PodFailurePolicyRuleApplyConfiguration
+		switch {
+		case len(rule.OnExitCodes) != 0:
+			exitCodes := slices.Clone(rule.OnExitCodes)
+			slices.Sort(exitCodes)
+			podFailurePolicyRule = batchv1ac.PodFailurePolicyRule().
+				WithAction(batchv1.PodFailurePolicyActionFailJob).
+				WithOnExitCodes(batchv1ac.PodFailurePolicyOnExitCodesRequirement().
+					WithOperator(batchv1.PodFailurePolicyOnExitCodesOpIn).
+					WithValues(exitCodes...))
+		case rule.OnPodDisruption:
+			podFailurePolicyRule = batchv1ac.PodFailurePolicyRule().
+				WithAction(batchv1.PodFailurePolicyActionIgnore).
+				WithOnPodConditions(batchv1ac.PodFailurePolicyOnPodConditionsPattern().
+					WithType(corev1.DisruptionTarget).
+					WithStatus(corev1.ConditionTrue))
+		}
+		if podFailurePolicyRule != nil {
+			targetJobs := rule.TargetJobs
+			if len(targetJobs) == 0 {
+				targetJobs = []string{constants.Node}
+			}
+			for _, rJob := range b.Spec.ReplicatedJobs {
+				if slices.Contains(targetJobs, *rJob.Name) {
+					podFailurePolicyRules[*rJob.Name] = append(podFailurePolicyRules[*rJob.Name], podFailurePolicyRule)
+				}
+			}
+		}
+		// The disrupted Pods are ignored by the Jobs, so the Job failures don't need to be matched.
+		if rule.OnPodDisruption {
+			continue
+		}
+		jobSetRule := jobsetv1alpha2ac.FailurePolicyRule().
+			WithName(fmt.Sprintf("%s%d", jobsetplgconsts.FailurePolicyRuleNamePrefix, i)).
+			WithAction(failurePolicyActions[rule.Action]).
+			WithTargetReplicatedJobs(rule.TargetJobs...)
+		if len(rule.OnExitCodes) != 0 {
+			jobSetRule.WithOnJobFailureReasons(batchv1.JobReasonPodFailurePolicy)
+		} else {
+			jobSetRule.WithOnJobFailureReasons(rule.OnJobFailureReasons...)
+		}
+		failurePolicy.WithRules(jobSetRule)
+	}
+	b.Spec.FailurePolicy = failurePolicy
+
+	for i, rJob := range b.Spec.ReplicatedJobs {
+		rules, ok := podFailurePolicyRules[*rJob.Name]
+		if !ok {
+			continue
+		}
+		jobSpec := b.Spec.ReplicatedJobs[i].Template.Spec
+		if jobSpec == nil {
+			continue
+		}
+		// The TrainJob rules are evaluated before the Job podFailurePolicy rules from the runtime template.
+		if jobSpec.PodFailurePolicy != nil {
+			for j := range jobSpec.PodFailurePolicy.Rules {
+				rules = append(rules, &jobSpec.PodFailurePolicy.Rules[j])
+			}
+		}
+		jobSpec.WithPodFailurePolicy(batchv1ac.PodFailurePolicy().WithRules(rules...))
+		// The Job podFailurePolicy requires the Never restartPolicy.
+		if jobSpec.Template != nil && jobSpec.Template.Spec != nil {
+			jobSpec.Template.Spec.WithRestartPolicy(corev1.RestartPolicyNever)
+		}
+	}
+	return b
+}
+
+var failurePolicyActions = map[trainer.FailurePolicyAction]jobsetv1alpha2.FailurePolicyAction{
+	trainer.FailurePolicyActionFail:                        jobsetv1alpha2.FailJobSet,
+	trainer.FailurePolicyActionRestart:                     jobsetv1alpha2.RestartJobSet,
+	trainer.FailurePolicyActionRestartAndIgnoreMaxRestarts: jobsetv1alpha2.RestartJobSetAndIgnoreMaxRestarts,
+}
+
 func (b *Builder) Suspend(suspend *bool) *Builder {
 	b.Spec.Suspend = suspend
 	return b
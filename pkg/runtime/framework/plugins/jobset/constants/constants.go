@@ -23,4 +23,8 @@ const (
 
 	// InitializerEnvStorageUri is the env name for the initializer storage uri.
 	InitializerEnvStorageUri string = "STORAGE_URI"
+
+	// FailurePolicyRuleNamePrefix is the name prefix for the JobSet failure policy rules
+	// translated from the TrainJob failure policy rules.
+	FailurePolicyRuleNamePrefix string = "trainJobFailurePolicyRule"
 )
@@ -18,6 +18,7 @@ package jobset
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 
@@ -45,11 +46,18 @@ import (
 	"github.com/kubeflow/trainer/v2/pkg/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+	"github.com/kubeflow/trainer/v2/pkg/util/trainjob"
+)
+
+var (
+	ErrorJobSetCRDNotInstalled = errors.New("JobSet CRDs must be installed in advance")
 )
 
 var (
 	runtimeRefPath      = field.NewPath("spec").Child("runtimeRef")
 	podSpecOverridePath = field.NewPath("spec").Child("podSpecOverrides")
+	numNodesPath        = field.NewPath("spec").Child("trainer").Child("numNodes")
+	failurePolicyPath   = field.NewPath("spec").Child("failurePolicy")
 )
 
 type JobSet struct {
@@ -64,12 +72,20 @@ var _ framework.PodNetworkPlugin = (*JobSet)(nil)
 var _ framework.ComponentBuilderPlugin = (*JobSet)(nil)
 var _ framework.TerminalConditionPlugin = (*JobSet)(nil)
 var _ framework.CustomValidationPlugin = (*JobSet)(nil)
+var _ framework.RunningConditionPlugin = (*JobSet)(nil)
+var _ framework.JobsStatusPlugin = (*JobSet)(nil)
 
 const Name = constants.JobSetKind
 
 // +kubebuilder:rbac:groups=jobset.x-k8s.io,resources=jobsets,verbs=create;get;list;watch;update;patch
 
 func New(ctx context.Context, client client.Client, _ client.FieldIndexer) (framework.Plugin, error) {
+	if _, err := client.RESTMapper().RESTMapping(
+		schema.GroupKind{Group: jobsetv1alpha2.GroupVersion.Group, Kind: constants.JobSetKind},
+		jobsetv1alpha2.SchemeGroupVersion.Version,
+	); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrorJobSetCRDNotInstalled, err)
+	}
 	return &JobSet{
 		client:     client,
 		restMapper: client.RESTMapper(),
@@ -118,6 +134,25 @@ func (j *JobSet) Validate(ctx context.Context, info *runtime.Info, oldObj, newOb
 		}
 	}
 
+	// The trainer nodes are evenly distributed across all replicated Jobs.
+	if newObj.Spec.Trainer != nil && newObj.Spec.Trainer.NumNodes != nil {
+		if trainerPS := info.FindPodSetByAncestor(constants.AncestorTrainer); trainerPS != nil {
+			if replicas := ptr.Deref(trainerPS.Replicas, constants.DefaultJobReplicas); *newObj.Spec.Trainer.NumNodes%replicas != 0 {
+				allErrs = append(allErrs, field.Invalid(numNodesPath, *newObj.Spec.Trainer.NumNodes, fmt.Sprintf("must be a multiple of the %s job replicas: %d", trainerPS.Name, replicas)))
+			}
+		}
+	}
+
+	if newObj.Spec.FailurePolicy != nil {
+		for i, rule := range newObj.Spec.FailurePolicy.Rules {
+			for k, targetJob := range rule.TargetJobs {
+				if _, ok := rJobContainerNames[targetJob]; !ok {
+					allErrs = append(allErrs, field.Invalid(failurePolicyPath.Child("rules").Index(i).Child("targetJobs").Index(k), targetJob, "must not have targetJob that doesn't exist in the runtime job template"))
+				}
+			}
+		}
+	}
+
 	allErrs = append(allErrs, j.checkPodSpecOverridesImmutability(ctx, oldObj, newObj)...)
 
 	// TODO (andreyvelich): Validate Volumes, VolumeMounts, and Tolerations.
@@ -191,13 +226,6 @@ func (j *JobSet) checkPodSpecOverridesImmutability(ctx context.Context, oldObj,
 }
 
 func (j *JobSet) ReconcilerBuilders() []runtime.ReconcilerBuilder {
-	if _, err := j.restMapper.RESTMapping(
-		schema.GroupKind{Group: jobsetv1alpha2.GroupVersion.Group, Kind: constants.JobSetKind},
-		jobsetv1alpha2.SchemeGroupVersion.Version,
-	); err != nil {
-		// TODO (tenzen-y): After we provide the Configuration API, we should return errors based on the enabled plugins.
-		j.logger.Error(err, "JobSet CRDs must be installed in advance")
-	}
 	return []runtime.ReconcilerBuilder{
 		func(b *builder.Builder, cl client.Client, cache cache.Cache) *builder.Builder {
 			return b.Watches(
@@ -223,15 +251,14 @@ func (j *JobSet) IdentifyPodNetwork(info *runtime.Info, trainJob *trainer.TrainJ
 		subDomain = *jobSetNet.Subdomain
 	}
 	for rJobIdx, rJob := range spec.ReplicatedJobs {
-		// TODO: Support multiple replicas for replicated Jobs.
-		// REF: https://github.com/kubeflow/trainer/issues/2318
-		podCount := info.TemplateSpec.PodSets[rJobIdx].Count
-		rJobReplicas := constants.DefaultJobReplicas
-		info.TemplateSpec.PodSets[rJobIdx].Endpoints = func(yield func(string) bool) {
-			for podIdx := range ptr.Deref(podCount, 1) {
-				endpoint := fmt.Sprintf("%s-%s-%d-%d.%s", trainJob.Name, *rJob.Name, rJobReplicas-1, podIdx, subDomain)
-				if !yield(endpoint) {
-					return
+		ps := &info.TemplateSpec.PodSets[rJobIdx]
+		ps.Endpoints = func(yield func(string) bool) {
+			for jobIdx := range ptr.Deref(ps.Replicas, constants.DefaultJobReplicas) {
+				for podIdx := range ps.Parallelism() {
+					endpoint := fmt.Sprintf("%s-%s-%d-%d.%s", trainJob.Name, *rJob.Name, jobIdx, podIdx, subDomain)
+					if !yield(endpoint) {
+						return
+					}
 				}
 			}
 		}
@@ -249,6 +276,13 @@ func (j *JobSet) Build(ctx context.Context, info *runtime.Info, trainJob *traine
 		return nil, nil
 	}
 
+	// The JobSet is suspended to terminate the Pods once the TrainJob exceeds the activeDeadlineSeconds.
+	suspend := trainJob.Spec.Suspend
+	deadlineExceeded := trainjob.IsDeadlineExceeded(trainJob)
+	if deadlineExceeded {
+		suspend = ptr.To(true)
+	}
+
 	// Do not update the JobSet if it already exists and is not suspended
 	oldJobSet := &jobsetv1alpha2.JobSet{}
 	if err := j.client.Get(ctx, client.ObjectKeyFromObject(trainJob), oldJobSet); err != nil {
@@ -257,8 +291,11 @@ func (j *JobSet) Build(ctx context.Context, info *runtime.Info, trainJob *traine
 		}
 		oldJobSet = nil
 	}
+	if deadlineExceeded && oldJobSet == nil {
+		return nil, nil
+	}
 	if oldJobSet != nil &&
-		!ptr.Deref(trainJob.Spec.Suspend, false) &&
+		!ptr.Deref(suspend, false) &&
 		!ptr.Deref(oldJobSet.Spec.Suspend, false) {
 		return nil, nil
 	}
@@ -272,10 +309,13 @@ func (j *JobSet) Build(ctx context.Context, info *runtime.Info, trainJob *traine
 	// TODO (andreyvelich): Refactor the builder with wrappers for PodSpec.
 	// TODO: Once we remove deprecated runtime.Info.Trainer, we should remove JobSet Builder with DeprecatedTrainer().
 	jobSet := jobSetBuilder.
+		Replicas(info).
 		Initializer(trainJob).
 		Trainer(info, trainJob).
 		PodLabels(info.Scheduler.PodLabels).
-		Suspend(trainJob.Spec.Suspend).
+		PodAnnotations(info.Scheduler.PodAnnotations).
+		FailurePolicy(trainJob).
+		Suspend(suspend).
 		Build().
 		WithOwnerReferences(metav1ac.OwnerReference().
 			WithAPIVersion(trainer.GroupVersion.String()).
@@ -303,3 +343,85 @@ func (j *JobSet) TerminalCondition(ctx context.Context, trainJob *trainer.TrainJ
 	}
 	return nil, nil
 }
+
+func (j *JobSet) RunningCondition(ctx context.Context, trainJob *trainer.TrainJob) (*metav1.Condition, error) {
+	jobSet := &jobsetv1alpha2.JobSet{}
+	if err := j.client.Get(ctx, client.ObjectKeyFromObject(trainJob), jobSet); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	switch {
+	case meta.IsStatusConditionTrue(jobSet.Status.Conditions, string(jobsetv1alpha2.JobSetCompleted)),
+		meta.IsStatusConditionTrue(jobSet.Status.Conditions, string(jobsetv1alpha2.JobSetFailed)):
+		return &metav1.Condition{
+			Type:    trainer.TrainJobRunning,
+			Status:  metav1.ConditionFalse,
+			Reason:  trainer.TrainJobFinishedReason,
+			Message: constants.TrainJobFinishedMessage,
+		}, nil
+	case ptr.Deref(jobSet.Spec.Suspend, false):
+		return &metav1.Condition{
+			Type:    trainer.TrainJobRunning,
+			Status:  metav1.ConditionFalse,
+			Reason:  trainer.TrainJobSuspendedReason,
+			Message: constants.TrainJobRunningSuspendedMessage,
+		}, nil
+	case isTrainerRunning(jobSet):
+		return &metav1.Condition{
+			Type:    trainer.TrainJobRunning,
+			Status:  metav1.ConditionTrue,
+			Reason:  trainer.TrainJobPodsRunningReason,
+			Message: constants.TrainJobPodsRunningMessage,
+		}, nil
+	default:
+		return &metav1.Condition{
+			Type:    trainer.TrainJobRunning,
+			Status:  metav1.ConditionFalse,
+			Reason:  trainer.TrainJobPodsPendingReason,
+			Message: constants.TrainJobPodsPendingMessage,
+		}, nil
+	}
+}
+
+// isTrainerRunning checks whether all the trainer Jobs have the ready Pods.
+// When the JobSet doesn't have any replicated Job with the trainer ancestor label,
+// all replicated Jobs are checked.
+func isTrainerRunning(jobSet *jobsetv1alpha2.JobSet) bool {
+	readyJobs := make(map[string]int32, len(jobSet.Status.ReplicatedJobsStatus))
+	for _, rJobStatus := range jobSet.Status.ReplicatedJobsStatus {
+		readyJobs[rJobStatus.Name] = rJobStatus.Ready
+	}
+	var trainerJobs []jobsetv1alpha2.ReplicatedJob
+	for _, rJob := range jobSet.Spec.ReplicatedJobs {
+		if rJob.Template.Labels[constants.LabelTrainJobAncestor] == constants.AncestorTrainer {
+			trainerJobs = append(trainerJobs, rJob)
+		}
+	}
+	if len(trainerJobs) == 0 {
+		trainerJobs = jobSet.Spec.ReplicatedJobs
+	}
+	for _, rJob := range trainerJobs {
+		if readyJobs[rJob.Name] < rJob.Replicas {
+			return false
+		}
+	}
+	return len(trainerJobs) != 0
+}
+
+func (j *JobSet) JobsStatus(ctx context.Context, trainJob *trainer.TrainJob) ([]trainer.JobStatus, *int32, error) {
+	jobSet := &jobsetv1alpha2.JobSet{}
+	if err := j.client.Get(ctx, client.ObjectKeyFromObject(trainJob), jobSet); err != nil {
+		return nil, nil, client.IgnoreNotFound(err)
+	}
+	var jobsStatus []trainer.JobStatus
+	for _, rJobStatus := range jobSet.Status.ReplicatedJobsStatus {
+		jobsStatus = append(jobsStatus, trainer.JobStatus{
+			Name:      rJobStatus.Name,
+			Ready:     rJobStatus.Ready,
+			Succeeded: rJobStatus.Succeeded,
+			Failed:    rJobStatus.Failed,
+			Active:    rJobStatus.Active,
+			Suspended: rJobStatus.Suspended,
+		})
+	}
+	return jobsStatus, ptr.To(jobSet.Status.Restarts), nil
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -42,6 +43,7 @@ import (
 	"github.com/kubeflow/trainer/v2/pkg/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+	jobsetplgconsts "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset/constants"
 	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
 )
 
@@ -198,6 +200,76 @@ func TestJobSet(t *testing.T) {
 				},
 			},
 		},
+		"endpoints are built across all replicated Jobs when replicas are more than 1": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Obj(),
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{
+						{
+							Name:       constants.Node,
+							Replicas:   ptr.To[int32](2),
+							Count:      ptr.To[int32](4),
+							Containers: make([]runtime.Container, 1),
+						},
+					},
+					ObjApply: jobsetv1alpha2ac.JobSetSpec().
+						WithReplicatedJobs(
+							jobsetv1alpha2ac.ReplicatedJob().
+								WithName(constants.Node).
+								WithReplicas(2).
+								WithTemplate(batchv1ac.JobTemplateSpec().
+									WithSpec(batchv1ac.JobSpec().
+										WithParallelism(2).
+										WithTemplate(corev1ac.PodTemplateSpec().
+											WithSpec(corev1ac.PodSpec().
+												WithContainers(
+													corev1ac.Container().WithName(constants.Node),
+												),
+											),
+										),
+									),
+								),
+						),
+				},
+			},
+			wantInfo: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{
+						{
+							Name:       constants.Node,
+							Replicas:   ptr.To[int32](2),
+							Count:      ptr.To[int32](4),
+							Containers: make([]runtime.Container, 1),
+							Endpoints: func(yield func(string) bool) {
+								yield("trainJob-node-0-0.trainJob")
+								yield("trainJob-node-0-1.trainJob")
+								yield("trainJob-node-1-0.trainJob")
+								yield("trainJob-node-1-1.trainJob")
+							},
+						},
+					},
+					ObjApply: jobsetv1alpha2ac.JobSetSpec().
+						WithReplicatedJobs(
+							jobsetv1alpha2ac.ReplicatedJob().
+								WithName(constants.Node).
+								WithReplicas(2).
+								WithTemplate(batchv1ac.JobTemplateSpec().
+									WithSpec(batchv1ac.JobSpec().
+										WithParallelism(2).
+										WithTemplate(corev1ac.PodTemplateSpec().
+											WithSpec(corev1ac.PodSpec().
+												WithContainers(
+													corev1ac.Container().WithName(constants.Node),
+												),
+											),
+										),
+									),
+								),
+						),
+				},
+			},
+		},
 		"subDomain in jobSetSpec is used to endpoint": {
 			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
 				Obj(),
@@ -352,6 +424,54 @@ func TestValidate(t *testing.T) {
 			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").Initializer(nil).
 				Obj(),
 		},
+		"failurePolicy must not have targetJob that doesn't exist in the runtime job template": {
+			info: &runtime.Info{TemplateSpec: runtime.TemplateSpec{
+				ObjApply: jobsetv1alpha2ac.JobSetSpec().
+					WithReplicatedJobs(jobsetv1alpha2ac.ReplicatedJob().
+						WithName(constants.Node).
+						WithTemplate(batchv1ac.JobTemplateSpec().
+							WithSpec(batchv1ac.JobSpec().
+								WithTemplate(corev1ac.PodTemplateSpec().
+									WithSpec(corev1ac.PodSpec()))))),
+			}},
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				FailurePolicy(&trainer.FailurePolicy{
+					Rules: []trainer.FailurePolicyRule{
+						{
+							Action:     trainer.FailurePolicyActionRestart,
+							TargetJobs: []string{constants.Node, "invalid"},
+						},
+					},
+				}).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(failurePolicyPath.Child("rules").Index(0).Child("targetJobs").Index(1), "invalid",
+					"must not have targetJob that doesn't exist in the runtime job template"),
+			},
+		},
+		"numNodes must be a multiple of the trainer replicas": {
+			info: &runtime.Info{
+				TemplateSpec: runtime.TemplateSpec{
+					ObjApply: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{},
+					PodSets: []runtime.PodSet{
+						{
+							Name:     constants.Node,
+							Ancestor: ptr.To(constants.AncestorTrainer),
+							Replicas: ptr.To[int32](2),
+							Count:    ptr.To[int32](4),
+						},
+					},
+				},
+			},
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					NumNodes(3).
+					Obj()).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(numNodesPath, int32(3), fmt.Sprintf("must be a multiple of the %s job replicas: %d", constants.Node, 2)),
+			},
+		},
 		"no dataset initializer job": {
 			info: &runtime.Info{TemplateSpec: runtime.TemplateSpec{
 				ObjApply: &jobsetv1alpha2ac.JobSetSpecApplyConfiguration{},
@@ -1284,3 +1404,221 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestBuild(t *testing.T) {
+	deadlineExceeded := metav1.Condition{
+		Type:   trainer.TrainJobFailed,
+		Status: metav1.ConditionTrue,
+		Reason: trainer.TrainJobDeadlineExceededReason,
+	}
+	cases := map[string]struct {
+		trainJob    *trainer.TrainJob
+		jobSet      *jobsetv1alpha2.JobSet
+		wantBuilt   bool
+		wantSuspend *bool
+	}{
+		"JobSet is built when it doesn't exist": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Obj(),
+			wantBuilt: true,
+		},
+		"running JobSet is not updated": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Obj(),
+			jobSet: utiltesting.MakeJobSetWrapper(metav1.NamespaceDefault, "trainJob").
+				Obj(),
+		},
+		"running JobSet is suspended when the TrainJob exceeds the activeDeadlineSeconds": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Condition(deadlineExceeded).
+				Obj(),
+			jobSet: utiltesting.MakeJobSetWrapper(metav1.NamespaceDefault, "trainJob").
+				Obj(),
+			wantBuilt:   true,
+			wantSuspend: ptr.To(true),
+		},
+		"JobSet is not created when the TrainJob exceeds the activeDeadlineSeconds": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Condition(deadlineExceeded).
+				Obj(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			clientBuilder := utiltesting.NewClientBuilder()
+			if tc.jobSet != nil {
+				clientBuilder.WithObjects(tc.jobSet)
+			}
+			p, err := New(ctx, clientBuilder.Build(), nil)
+			if err != nil {
+				t.Fatalf("Failed to initialize JobSet plugin: %v", err)
+			}
+			info := runtime.NewInfo(runtime.WithTemplateSpecObjApply(jobsetv1alpha2ac.JobSetSpec()))
+			objs, err := p.(framework.ComponentBuilderPlugin).Build(ctx, info, tc.trainJob)
+			if err != nil {
+				t.Fatalf("Unexpected error from Build: %v", err)
+			}
+			if gotBuilt := len(objs) != 0; gotBuilt != tc.wantBuilt {
+				t.Fatalf("Unexpected built objects, want built: %v, got: %v", tc.wantBuilt, objs)
+			}
+			if !tc.wantBuilt {
+				return
+			}
+			jobSet, ok := objs[0].(*jobsetv1alpha2ac.JobSetApplyConfiguration)
+			if !ok {
+				t.Fatalf("Unexpected object type: %T", objs[0])
+			}
+			if diff := cmp.Diff(tc.wantSuspend, jobSet.Spec.Suspend); len(diff) != 0 {
+				t.Errorf("Unexpected JobSet suspend (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFailurePolicy(t *testing.T) {
+	newJobSetSpec := func() *jobsetv1alpha2ac.JobSetSpecApplyConfiguration {
+		return jobsetv1alpha2ac.JobSetSpec().
+			WithFailurePolicy(jobsetv1alpha2ac.FailurePolicy().
+				WithRestartStrategy(jobsetv1alpha2.BlockingRecreate)).
+			WithReplicatedJobs(
+				jobsetv1alpha2ac.ReplicatedJob().
+					WithName(constants.DatasetInitializer).
+					WithTemplate(batchv1ac.JobTemplateSpec().
+						WithSpec(batchv1ac.JobSpec().
+							WithTemplate(corev1ac.PodTemplateSpec().
+								WithSpec(corev1ac.PodSpec().
+									WithRestartPolicy(corev1.RestartPolicyOnFailure))))),
+				jobsetv1alpha2ac.ReplicatedJob().
+					WithName(constants.Node).
+					WithTemplate(batchv1ac.JobTemplateSpec().
+						WithSpec(batchv1ac.JobSpec().
+							WithPodFailurePolicy(batchv1ac.PodFailurePolicy().
+								WithRules(batchv1ac.PodFailurePolicyRule().
+									WithAction(batchv1.PodFailurePolicyActionCount).
+									WithOnExitCodes(batchv1ac.PodFailurePolicyOnExitCodesRequirement().
+										WithOperator(batchv1.PodFailurePolicyOnExitCodesOpIn).
+										WithValues(1)))).
+							WithTemplate(corev1ac.PodTemplateSpec().
+								WithSpec(corev1ac.PodSpec().
+									WithRestartPolicy(corev1.RestartPolicyOnFailure))))),
+			)
+	}
+	cases := map[string]struct {
+		trainJob *trainer.TrainJob
+		want     *jobsetv1alpha2ac.JobSetSpecApplyConfiguration
+	}{
+		"failurePolicy is not set": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Obj(),
+			want: newJobSetSpec(),
+		},
+		"maxRestarts is propagated and the runtime restartStrategy is kept": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				FailurePolicy(&trainer.FailurePolicy{
+					MaxRestarts: ptr.To[int32](3),
+				}).
+				Obj(),
+			want: newJobSetSpec().
+				WithFailurePolicy(jobsetv1alpha2ac.FailurePolicy().
+					WithMaxRestarts(3).
+					WithRestartStrategy(jobsetv1alpha2.BlockingRecreate)),
+		},
+		"rules are translated into JobSet and Job failure policies": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				FailurePolicy(&trainer.FailurePolicy{
+					MaxRestarts: ptr.To[int32](2),
+					Rules: []trainer.FailurePolicyRule{
+						{
+							Action:      trainer.FailurePolicyActionFail,
+							OnExitCodes: []int32{42, 3},
+							TargetJobs:  []string{constants.Node},
+						},
+						{
+							Action:          trainer.FailurePolicyActionRestartAndIgnoreMaxRestarts,
+							OnPodDisruption: true,
+						},
+						{
+							Action:              trainer.FailurePolicyActionRestart,
+							OnJobFailureReasons: []string{batchv1.JobReasonBackoffLimitExceeded},
+						},
+					},
+				}).
+				Obj(),
+			want: func() *jobsetv1alpha2ac.JobSetSpecApplyConfiguration {
+				disruptionRule := batchv1ac.PodFailurePolicyRule().
+					WithAction(batchv1.PodFailurePolicyActionIgnore).
+					WithOnPodConditions(batchv1ac.PodFailurePolicyOnPodConditionsPattern().
+						WithType(corev1.DisruptionTarget).
+						WithStatus(corev1.ConditionTrue))
+				spec := newJobSetSpec().
+					WithFailurePolicy(jobsetv1alpha2ac.FailurePolicy().
+						WithMaxRestarts(2).
+						WithRestartStrategy(jobsetv1alpha2.BlockingRecreate).
+						WithRules(
+							jobsetv1alpha2ac.FailurePolicyRule().
+								WithName(jobsetplgconsts.FailurePolicyRuleNamePrefix+"0").
+								WithAction(jobsetv1alpha2.FailJobSet).
+								WithTargetReplicatedJobs(constants.Node).
+								WithOnJobFailureReasons(batchv1.JobReasonPodFailurePolicy),
+							jobsetv1alpha2ac.FailurePolicyRule().
+								WithName(jobsetplgconsts.FailurePolicyRuleNamePrefix+"2").
+								WithAction(jobsetv1alpha2.RestartJobSet).
+								WithOnJobFailureReasons(batchv1.JobReasonBackoffLimitExceeded),
+						))
+				nodeSpec := spec.ReplicatedJobs[1].Template.Spec
+				nodeSpec.PodFailurePolicy.Rules = append([]batchv1ac.PodFailurePolicyRuleApplyConfiguration{
+					*batchv1ac.PodFailurePolicyRule().
+						WithAction(batchv1.PodFailurePolicyActionFailJob).
+						WithOnExitCodes(batchv1ac.PodFailurePolicyOnExitCodesRequirement().
+							WithOperator(batchv1.PodFailurePolicyOnExitCodesOpIn).
+							WithValues(3, 42)),
+					*disruptionRule,
+				}, nodeSpec.PodFailurePolicy.Rules...)
+				nodeSpec.Template.Spec.WithRestartPolicy(corev1.RestartPolicyNever)
+				return spec
+			}(),
+		},
+		"onPodDisruption rule targeting the initializer sets the Never restartPolicy for the initializer": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				FailurePolicy(&trainer.FailurePolicy{
+					Rules: []trainer.FailurePolicyRule{
+						{
+							Action:          trainer.FailurePolicyActionRestartAndIgnoreMaxRestarts,
+							OnPodDisruption: true,
+							TargetJobs:      []string{constants.DatasetInitializer},
+						},
+					},
+				}).
+				Obj(),
+			want: func() *jobsetv1alpha2ac.JobSetSpecApplyConfiguration {
+				spec := newJobSetSpec().
+					WithFailurePolicy(jobsetv1alpha2ac.FailurePolicy().
+						WithMaxRestarts(0).
+						WithRestartStrategy(jobsetv1alpha2.BlockingRecreate))
+				initializerSpec := spec.ReplicatedJobs[0].Template.Spec
+				initializerSpec.PodFailurePolicy = batchv1ac.PodFailurePolicy().
+					WithRules(batchv1ac.PodFailurePolicyRule().
+						WithAction(batchv1.PodFailurePolicyActionIgnore).
+						WithOnPodConditions(batchv1ac.PodFailurePolicyOnPodConditionsPattern().
+							WithType(corev1.DisruptionTarget).
+							WithStatus(corev1.ConditionTrue)))
+				initializerSpec.Template.Spec.WithRestartPolicy(corev1.RestartPolicyNever)
+				return spec
+			}(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			b := NewBuilder(jobsetv1alpha2ac.JobSet(tc.trainJob.Name, tc.trainJob.Namespace).
+				WithSpec(newJobSetSpec()))
+			got := b.FailurePolicy(tc.trainJob).Spec
+			if diff := cmp.Diff(tc.want, got); len(diff) != 0 {
+				t.Errorf("Unexpected JobSet spec (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
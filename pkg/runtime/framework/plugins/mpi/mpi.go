@@ -31,6 +31,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
 	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -50,10 +51,9 @@ import (
 
 var (
 	numProcPerNodePath = field.NewPath("spec").Child("trainer").Child("numProcPerNode")
+	trainerEnvPath     = field.NewPath("spec").Child("trainer").Child("env")
 )
 
-// TODO : Support MPICH and IntelMPI implementations.
-
 type MPI struct {
 	client client.Client
 	scheme *apiruntime.Scheme
@@ -63,6 +63,7 @@ var _ framework.CustomValidationPlugin = (*MPI)(nil)
 var _ framework.EnforceMLPolicyPlugin = (*MPI)(nil)
 var _ framework.WatchExtensionPlugin = (*MPI)(nil)
 var _ framework.ComponentBuilderPlugin = (*MPI)(nil)
+var _ framework.RuntimeValidationPlugin = (*MPI)(nil)
 
 const Name = "MPI"
 
@@ -80,7 +81,18 @@ func (m *MPI) Name() string {
 	return Name
 }
 
-// TODO (andreyvelich): Add validation to check that TrainJob doesn't have MPI envs.
+func (m *MPI) ValidateRuntime(_ context.Context, spec *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList) {
+	if spec.MLPolicy == nil || spec.MLPolicy.MPI == nil {
+		return nil, nil
+	}
+	allErrs := runtime.ValidateReplicatedJob(spec, constants.Launcher, "MPI")
+	// The launcher runs the training processes as well as the nodes when runLauncherAsNode is enabled.
+	if !ptr.Deref(spec.MLPolicy.MPI.RunLauncherAsNode, false) {
+		allErrs = append(allErrs, runtime.ValidateReplicatedJob(spec, constants.Node, "MPI")...)
+	}
+	return nil, allErrs
+}
+
 // TODO (andreyvelich): We should validate that envs from different plugins don't conflict with each other.
 // Ref: https://github.com/kubeflow/trainer/pull/2308#discussion_r1823229940
 func (m *MPI) Validate(_ context.Context, runtimeInfo *runtime.Info, _, newJobObj *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
@@ -101,9 +113,50 @@ func (m *MPI) Validate(_ context.Context, runtimeInfo *runtime.Info, _, newJobOb
 			allErrs = append(allErrs, field.Invalid(numNodesPath, newJobObj.Spec.Trainer.NumNodes, "must have 1 when MPI trainingRuntime with enabled runLauncherAsNode does not have either launcher and node"))
 		}
 	}
+	switch ptr.Deref(runtimeInfo.RuntimePolicy.MLPolicySource.MPI.MPIImplementation, trainer.MPIImplementationOpenMPI) {
+	case trainer.MPIImplementationOpenMPI:
+		allErrs = append(allErrs, validateOpenMPI(newJobObj)...)
+	case trainer.MPIImplementationIntel:
+		allErrs = append(allErrs, validateIntelMPI(newJobObj)...)
+	case trainer.MPIImplementationMPICH:
+		allErrs = append(allErrs, validateMPICH(newJobObj)...)
+	}
 	return nil, allErrs
 }
 
+// validateOpenMPI validates the TrainJob for the OpenMPI implementation.
+func validateOpenMPI(trainJob *trainer.TrainJob) field.ErrorList {
+	return validateReservedEnvs(trainJob, constants.OpenMPIReservedEnvNames)
+}
+
+// validateIntelMPI validates the TrainJob for the Intel MPI implementation.
+func validateIntelMPI(trainJob *trainer.TrainJob) field.ErrorList {
+	return validateReservedEnvs(trainJob, constants.IntelMPIReservedEnvNames)
+}
+
+// validateMPICH validates the TrainJob for the MPICH implementation.
+func validateMPICH(trainJob *trainer.TrainJob) field.ErrorList {
+	return validateReservedEnvs(trainJob, constants.MPICHReservedEnvNames)
+}
+
+// validateReservedEnvs checks that the TrainJob trainer doesn't override envs configured by the MPI plugin.
+func validateReservedEnvs(trainJob *trainer.TrainJob, reservedEnvNames sets.Set[string]) field.ErrorList {
+	var allErrs field.ErrorList
+	if trainJob.Spec.Trainer == nil {
+		return allErrs
+	}
+	mpiEnvs := sets.New[string]()
+	for _, env := range trainJob.Spec.Trainer.Env {
+		if reservedEnvNames.Has(env.Name) {
+			mpiEnvs.Insert(env.Name)
+		}
+	}
+	if mpiEnvs.Len() > 0 {
+		allErrs = append(allErrs, field.Invalid(trainerEnvPath, trainJob.Spec.Trainer.Env, fmt.Sprintf("must not have reserved envs, invalid envs configured: %v", sets.List(mpiEnvs))))
+	}
+	return allErrs
+}
+
 func (m *MPI) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
 	if info == nil || info.RuntimePolicy.MLPolicySource == nil || info.RuntimePolicy.MLPolicySource.MPI == nil {
 		return nil
@@ -160,7 +213,7 @@ func (m *MPI) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) er
 					*corev1ac.Volume().
 						WithName(constants.MPIHostfileVolumeName).
 						WithConfigMap(corev1ac.ConfigMapVolumeSource().
-							WithName(fmt.Sprintf("%s%s", trainJob.Name, constants.MPIHostfileConfigMapSuffix)).
+							WithName(HostFileConfigMapName(trainJob.Name)).
 							WithItems(
 								corev1ac.KeyToPath().
 									WithKey(constants.MPIHostfileName).
@@ -207,8 +260,31 @@ func (m *MPI) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) er
 							WithName(constants.OpenMPIEnvKeyRSHArgs).
 							WithValue(constants.OpenMPIEnvDefaultValueRSHArgs),
 					)
+				case trainer.MPIImplementationIntel:
+					apply.UpsertEnvVars(
+						&info.TemplateSpec.PodSets[psIdx].Containers[cIdx].Env,
+						*corev1ac.EnvVar().
+							WithName(constants.IntelMPIEnvHostFileLocation).
+							WithValue(fmt.Sprintf("%s/%s", constants.MPIHostfileDir, constants.MPIHostfileName)),
+						*corev1ac.EnvVar().
+							WithName(constants.IntelMPIEnvPerHost).
+							WithValue(strconv.Itoa(int(*info.RuntimePolicy.MLPolicySource.MPI.NumProcPerNode))),
+						*corev1ac.EnvVar().
+							WithName(constants.IntelMPIEnvKeyBootstrapArgs).
+							WithValue(constants.MPIEnvDefaultValueSSHArgs),
+					)
+				case trainer.MPIImplementationMPICH:
+					apply.UpsertEnvVars(
+						&info.TemplateSpec.PodSets[psIdx].Containers[cIdx].Env,
+						*corev1ac.EnvVar().
+							WithName(constants.MPICHEnvHostFileLocation).
+							WithValue(fmt.Sprintf("%s/%s", constants.MPIHostfileDir, constants.MPIHostfileName)),
+						*corev1ac.EnvVar().
+							WithName(constants.MPICHEnvKeyLaunchArgs).
+							WithValue(constants.MPIEnvDefaultValueSSHArgs),
+					)
 				default:
-					return fmt.Errorf("MPI implementation for %v doesn't supported", info.RuntimePolicy.MLPolicySource.MPI.MPIImplementation)
+					return fmt.Errorf("MPI implementation for %v doesn't supported", *info.RuntimePolicy.MLPolicySource.MPI.MPIImplementation)
 				}
 			}
 		}
@@ -218,12 +294,17 @@ func (m *MPI) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) er
 }
 
 func (m *MPI) ReconcilerBuilders() []runtime.ReconcilerBuilder {
+	return OwnedResourceReconcilerBuilders(m.client)
+}
+
+// OwnedResourceReconcilerBuilders watches the SSH auth Secret and the hostfile ConfigMap owned by the TrainJob.
+func OwnedResourceReconcilerBuilders(c client.Client) []runtime.ReconcilerBuilder {
 	return []runtime.ReconcilerBuilder{
 		func(b *builder.Builder, cl client.Client, cache cache.Cache) *builder.Builder {
 			return b.Watches(
 				&corev1.ConfigMap{},
 				handler.EnqueueRequestForOwner(
-					m.client.Scheme(), m.client.RESTMapper(), &trainer.TrainJob{}, handler.OnlyControllerOwner(),
+					c.Scheme(), c.RESTMapper(), &trainer.TrainJob{}, handler.OnlyControllerOwner(),
 				),
 			)
 		},
@@ -231,7 +312,7 @@ func (m *MPI) ReconcilerBuilders() []runtime.ReconcilerBuilder {
 			return b.Watches(
 				&corev1.Secret{},
 				handler.EnqueueRequestForOwner(
-					m.client.Scheme(), m.client.RESTMapper(), &trainer.TrainJob{}, handler.OnlyControllerOwner(),
+					c.Scheme(), c.RESTMapper(), &trainer.TrainJob{}, handler.OnlyControllerOwner(),
 				),
 			)
 		},
@@ -246,11 +327,11 @@ func (m *MPI) Build(ctx context.Context, info *runtime.Info, trainJob *trainer.T
 	var objects []any
 
 	// SSHAuthSecret is immutable.
-	if err := m.client.Get(ctx, client.ObjectKey{Name: sshAuthSecretName(trainJob.Name), Namespace: trainJob.Namespace}, &corev1.Secret{}); err != nil {
+	if err := m.client.Get(ctx, client.ObjectKey{Name: SSHAuthSecretName(trainJob.Name), Namespace: trainJob.Namespace}, &corev1.Secret{}); err != nil {
 		if client.IgnoreNotFound(err) != nil {
 			return nil, err
 		}
-		secret, err := m.buildSSHAuthSecret(trainJob)
+		secret, err := BuildSSHAuthSecret(trainJob)
 		if err != nil {
 			return nil, fmt.Errorf("failed to build SSH Auth secret: %w", err)
 		}
@@ -259,7 +340,9 @@ func (m *MPI) Build(ctx context.Context, info *runtime.Info, trainJob *trainer.T
 	return append(objects, m.buildHostFileConfigMap(info, trainJob)), nil
 }
 
-func (m *MPI) buildSSHAuthSecret(trainJob *trainer.TrainJob) (*corev1ac.SecretApplyConfiguration, error) {
+// BuildSSHAuthSecret generates the SSH keys, and builds the immutable Secret with them
+// to allow the launcher to connect to the training nodes.
+func BuildSSHAuthSecret(trainJob *trainer.TrainJob) (*corev1ac.SecretApplyConfiguration, error) {
 	privateKey, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
 	if err != nil {
 		return nil, err
@@ -276,7 +359,7 @@ func (m *MPI) buildSSHAuthSecret(trainJob *trainer.TrainJob) (*corev1ac.SecretAp
 	if err != nil {
 		return nil, err
 	}
-	return corev1ac.Secret(sshAuthSecretName(trainJob.Name), trainJob.Namespace).
+	return corev1ac.Secret(SSHAuthSecretName(trainJob.Name), trainJob.Namespace).
 		WithType(corev1.SecretTypeSSHAuth).
 		WithData(map[string][]byte{
 			corev1.SSHAuthPrivateKey:  privatePEM,
@@ -292,26 +375,41 @@ func (m *MPI) buildSSHAuthSecret(trainJob *trainer.TrainJob) (*corev1ac.SecretAp
 			WithBlockOwnerDeletion(true)), nil
 }
 
-func sshAuthSecretName(trainJobName string) string {
+// SSHAuthSecretName returns the name of the Secret with the SSH keys.
+func SSHAuthSecretName(trainJobName string) string {
 	return fmt.Sprintf("%s%s", trainJobName, constants.MPISSHAuthSecretSuffix)
 }
 
 func (m *MPI) buildHostFileConfigMap(info *runtime.Info, trainJob *trainer.TrainJob) *corev1ac.ConfigMapApplyConfiguration {
-	var hostFile bytes.Buffer
 	runLauncherAsNode := ptr.Deref(info.RuntimePolicy.MLPolicySource.MPI.RunLauncherAsNode, false)
 	slots := ptr.Deref(info.RuntimePolicy.MLPolicySource.MPI.NumProcPerNode, 1)
-	for _, ps := range info.TemplateSpec.PodSets {
-		if !isNode(runLauncherAsNode, ps) {
-			continue
-		}
-		switch *info.RuntimePolicy.MLPolicySource.MPI.MPIImplementation {
-		case trainer.MPIImplementationOpenMPI:
+	var hostFormat string
+	switch *info.RuntimePolicy.MLPolicySource.MPI.MPIImplementation {
+	case trainer.MPIImplementationOpenMPI:
+		hostFormat = "%s slots=%d\n"
+	case trainer.MPIImplementationIntel, trainer.MPIImplementationMPICH:
+		hostFormat = "%s:%d\n"
+	}
+	return BuildHostFileConfigMap(info, trainJob, runLauncherAsNode, hostFormat, slots)
+}
+
+// BuildHostFileConfigMap builds the hostfile ConfigMap with one line per node endpoint.
+// The hostFormat is formatted with the endpoint and the number of slots.
+func BuildHostFileConfigMap(
+	info *runtime.Info, trainJob *trainer.TrainJob, runLauncherAsNode bool, hostFormat string, slots int32,
+) *corev1ac.ConfigMapApplyConfiguration {
+	var hostFile bytes.Buffer
+	if len(hostFormat) != 0 {
+		for _, ps := range info.TemplateSpec.PodSets {
+			if !IsNode(runLauncherAsNode, ps) || ps.Endpoints == nil {
+				continue
+			}
 			for e := range ps.Endpoints {
-				hostFile.WriteString(fmt.Sprintf("%s slots=%d\n", e, slots))
+				hostFile.WriteString(fmt.Sprintf(hostFormat, e, slots))
 			}
 		}
 	}
-	return corev1ac.ConfigMap(fmt.Sprintf("%s%s", trainJob.Name, constants.MPIHostfileConfigMapSuffix), trainJob.Namespace).
+	return corev1ac.ConfigMap(HostFileConfigMapName(trainJob.Name), trainJob.Namespace).
 		WithData(map[string]string{
 			constants.MPIHostfileName: hostFile.String(),
 		}).
@@ -324,6 +422,12 @@ func (m *MPI) buildHostFileConfigMap(info *runtime.Info, trainJob *trainer.Train
 			WithBlockOwnerDeletion(true))
 }
 
-func isNode(runLauncherAsNode bool, ps runtime.PodSet) bool {
+// HostFileConfigMapName returns the name of the ConfigMap with the hostfile.
+func HostFileConfigMapName(trainJobName string) string {
+	return fmt.Sprintf("%s%s", trainJobName, constants.MPIHostfileConfigMapSuffix)
+}
+
+// IsNode returns true when the PodSet runs the training processes.
+func IsNode(runLauncherAsNode bool, ps runtime.PodSet) bool {
 	return (runLauncherAsNode && ps.Name == constants.Launcher) || ps.Name == constants.Node
 }
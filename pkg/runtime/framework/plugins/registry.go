@@ -19,14 +19,20 @@ package plugins
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/coscheduling"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/deepspeed"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jax"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/mpi"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/plainml"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/tensorflow"
 	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/torch"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/volcano"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/xgboost"
 )
 
 type Registry map[string]func(ctx context.Context, client client.Client, indexer client.FieldIndexer) (framework.Plugin, error)
@@ -38,5 +44,27 @@ func NewRegistry() Registry {
 		plainml.Name:      plainml.New,
 		torch.Name:        torch.New,
 		jobset.Name:       jobset.New,
+		jax.Name:          jax.New,
+		tensorflow.Name:   tensorflow.New,
+		xgboost.Name:      xgboost.New,
+		deepspeed.Name:    deepspeed.New,
+		volcano.Name:      volcano.New,
 	}
 }
+
+// optionalPlugins depend on external CRDs, hence they are enabled only when specified in the Configuration.
+var optionalPlugins = sets.New(volcano.Name)
+
+// NewEnabledRegistry returns the Registry composed of the default plugins and the enabled ones,
+// excluding the disabled ones.
+func NewEnabledRegistry(enabled, disabled []string) Registry {
+	registry := NewRegistry()
+	enabledSet := sets.New(enabled...)
+	disabledSet := sets.New(disabled...)
+	for name := range registry {
+		if disabledSet.Has(name) || (optionalPlugins.Has(name) && !enabledSet.Has(name)) {
+			delete(registry, name)
+		}
+	}
+	return registry
+}
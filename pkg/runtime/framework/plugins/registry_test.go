@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/coscheduling"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/deepspeed"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jax"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/mpi"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/plainml"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/tensorflow"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/torch"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/volcano"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/xgboost"
+)
+
+func TestNewEnabledRegistry(t *testing.T) {
+	defaultPlugins := []string{
+		coscheduling.Name, mpi.Name, plainml.Name, torch.Name, jobset.Name, jax.Name, tensorflow.Name, xgboost.Name, deepspeed.Name,
+	}
+	cases := map[string]struct {
+		enabled     []string
+		disabled    []string
+		wantPlugins []string
+	}{
+		"optional plugins are not enabled by default": {
+			wantPlugins: defaultPlugins,
+		},
+		"optional plugins are enabled when specified": {
+			enabled:     []string{volcano.Name},
+			wantPlugins: append([]string{volcano.Name}, defaultPlugins...),
+		},
+		"disabled plugins are removed": {
+			enabled:     []string{volcano.Name},
+			disabled:    []string{coscheduling.Name, xgboost.Name, deepspeed.Name},
+			wantPlugins: []string{volcano.Name, mpi.Name, plainml.Name, torch.Name, jobset.Name, jax.Name, tensorflow.Name},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := sets.List(sets.KeySet(NewEnabledRegistry(tc.enabled, tc.disabled)))
+			if diff := cmp.Diff(tc.wantPlugins, got, cmpopts.SortSlices(func(a, b string) bool { return a < b })); len(diff) != 0 {
+				t.Errorf("Unexpected plugins (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tensorflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/apply"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+)
+
+type TensorFlow struct{}
+
+var _ framework.EnforceMLPolicyPlugin = (*TensorFlow)(nil)
+var _ framework.CustomValidationPlugin = (*TensorFlow)(nil)
+var _ framework.RuntimeValidationPlugin = (*TensorFlow)(nil)
+
+const Name = "TensorFlow"
+
+func New(context.Context, client.Client, client.FieldIndexer) (framework.Plugin, error) {
+	return &TensorFlow{}, nil
+}
+
+func (t *TensorFlow) Name() string {
+	return Name
+}
+
+func (t *TensorFlow) ValidateRuntime(_ context.Context, spec *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList) {
+	if spec.MLPolicy == nil || spec.MLPolicy.TensorFlow == nil {
+		return nil, nil
+	}
+	return nil, runtime.ValidateAncestorContainer(spec, constants.AncestorTrainer, constants.Node, "TensorFlow")
+}
+
+func (t *TensorFlow) Validate(_ context.Context, runtimeInfo *runtime.Info, _, newObj *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
+	var allErrs field.ErrorList
+	if runtimeInfo == nil || runtimeInfo.RuntimePolicy.MLPolicySource == nil || runtimeInfo.RuntimePolicy.MLPolicySource.TensorFlow == nil || newObj.Spec.Trainer == nil {
+		return nil, allErrs
+	}
+
+	trainerPath := field.NewPath("spec").Child("trainer")
+
+	// Each TensorFlow task runs in a single process.
+	if newObj.Spec.Trainer.NumProcPerNode != nil {
+		allErrs = append(allErrs, field.Forbidden(trainerPath.Child("numProcPerNode"), "must not be set for TensorFlow TrainJob"))
+	}
+
+	// Check reserved envs.
+	tfEnvs := sets.New[string]()
+	for _, env := range newObj.Spec.Trainer.Env {
+		if constants.TensorFlowReservedEnvNames.Has(env.Name) {
+			tfEnvs.Insert(env.Name)
+		}
+	}
+	if tfEnvs.Len() > 0 {
+		allErrs = append(allErrs, field.Invalid(trainerPath.Child("env"), newObj.Spec.Trainer.Env, fmt.Sprintf("must not have reserved envs, invalid envs configured: %v", sets.List(tfEnvs))))
+	}
+	return nil, allErrs
+}
+
+func (t *TensorFlow) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
+	if info == nil || info.RuntimePolicy.MLPolicySource == nil || info.RuntimePolicy.MLPolicySource.TensorFlow == nil {
+		return nil
+	}
+
+	// TrainJob contains the actual information for the Trainer.
+	trainerPS := info.FindPodSetByAncestor(constants.AncestorTrainer)
+	if trainerPS != nil && trainerPS.Count != nil && trainJob.Spec.Trainer != nil && trainJob.Spec.Trainer.NumNodes != nil {
+		*trainerPS.Count = *trainJob.Spec.Trainer.NumNodes
+	}
+
+	// Build the TensorFlow cluster spec from the PodSet endpoints.
+	cluster := make(map[string][]string)
+	for _, ps := range info.TemplateSpec.PodSets {
+		role, ok := taskType(ps)
+		if !ok || ps.Endpoints == nil {
+			continue
+		}
+		for e := range ps.Endpoints {
+			cluster[role] = append(cluster[role], fmt.Sprintf("%s:%d", e, constants.ContainerTrainerPort))
+		}
+	}
+	clusterSpec, err := json.Marshal(cluster)
+	if err != nil {
+		return fmt.Errorf("failed to build TensorFlow cluster spec: %w", err)
+	}
+
+	// Update envs for Info object.
+	for psIdx, ps := range info.TemplateSpec.PodSets {
+		role, ok := taskType(ps)
+		if !ok {
+			continue
+		}
+		for cIdx, container := range ps.Containers {
+			if container.Name != constants.Node {
+				continue
+			}
+			env := &info.TemplateSpec.PodSets[psIdx].Containers[cIdx].Env
+			if trainJob.Spec.Trainer != nil {
+				apply.UpsertEnvVars(env, apply.EnvVars(trainJob.Spec.Trainer.Env...)...)
+			}
+			// The task index is resolved for each Pod by the dependent environment variable.
+			apply.UpsertEnvVar(env,
+				*corev1ac.EnvVar().
+					WithName(constants.TensorFlowEnvTaskIndex).
+					WithValueFrom(corev1ac.EnvVarSource().
+						WithFieldRef(corev1ac.ObjectFieldSelector().
+							WithFieldPath(constants.JobCompletionIndexFieldPath))),
+				*corev1ac.EnvVar().
+					WithName(constants.TensorFlowEnvConfig).
+					WithValue(fmt.Sprintf(`{"cluster":%s,"task":{"type":"%s","index":$(%s)}}`, clusterSpec, role, constants.TensorFlowEnvTaskIndex)),
+			)
+			// Add container port for the headless service.
+			apply.UpsertPort(&info.TemplateSpec.PodSets[psIdx].Containers[cIdx].Ports, *corev1ac.ContainerPort().WithContainerPort(constants.ContainerTrainerPort))
+		}
+	}
+	info.SyncPodSetsToTemplateSpec()
+	return nil
+}
+
+// taskType returns the TensorFlow task type which is mapped from the PodSet ancestor.
+func taskType(ps runtime.PodSet) (string, bool) {
+	switch ptr.Deref(ps.Ancestor, "") {
+	case constants.AncestorChief:
+		return constants.TensorFlowRoleChief, true
+	case constants.AncestorTrainer:
+		return constants.TensorFlowRoleWorker, true
+	case constants.AncestorParameterServer:
+		return constants.TensorFlowRolePS, true
+	}
+	return "", false
+}
@@ -0,0 +1,324 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tensorflow
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/klog/v2/ktesting"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+func TestTensorFlow(t *testing.T) {
+	taskIndexEnv := corev1ac.EnvVarApplyConfiguration{
+		Name: ptr.To(constants.TensorFlowEnvTaskIndex),
+		ValueFrom: &corev1ac.EnvVarSourceApplyConfiguration{
+			FieldRef: &corev1ac.ObjectFieldSelectorApplyConfiguration{
+				FieldPath: ptr.To(constants.JobCompletionIndexFieldPath),
+			},
+		},
+	}
+	ports := []corev1ac.ContainerPortApplyConfiguration{{
+		ContainerPort: ptr.To[int32](constants.ContainerTrainerPort),
+	}}
+	clusterSpec := fmt.Sprintf(`{"chief":["trainJob-chief-0-0.trainJob:%[1]d"],"ps":["trainJob-ps-0-0.trainJob:%[1]d"],"worker":["trainJob-node-0-0.trainJob:%[1]d","trainJob-node-0-1.trainJob:%[1]d"]}`,
+		constants.ContainerTrainerPort)
+
+	cases := map[string]struct {
+		info              *runtime.Info
+		trainJob          *trainer.TrainJob
+		wantInfo          *runtime.Info
+		wantMLPolicyError error
+	}{
+		"no action when info is nil": {},
+		"no action when mlPolicySource is nil": {
+			info: runtime.NewInfo(
+				runtime.WithLabels(map[string]string{"key": "value"}),
+			),
+			wantInfo: runtime.NewInfo(
+				runtime.WithLabels(map[string]string{"key": "value"}),
+			),
+		},
+		"no action when mlPolicySource tensorflow is null": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(utiltesting.MakeMLPolicyWrapper().
+					Obj(),
+				),
+			),
+			wantInfo: runtime.NewInfo(
+				runtime.WithMLPolicySource(utiltesting.MakeMLPolicyWrapper().
+					Obj(),
+				),
+			),
+		},
+		"TF_CONFIG is built from chief, worker, and ps endpoints": {
+			info: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TensorFlowPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{
+						{
+							Name:     constants.AncestorChief,
+							Ancestor: ptr.To(constants.AncestorChief),
+							Count:    ptr.To[int32](1),
+							Endpoints: func(yield func(string) bool) {
+								yield("trainJob-chief-0-0.trainJob")
+							},
+							Containers: []runtime.Container{{
+								Name: constants.Node,
+							}},
+						},
+						{
+							Name:     constants.Node,
+							Ancestor: ptr.To(constants.AncestorTrainer),
+							Count:    ptr.To[int32](1),
+							Endpoints: func(yield func(string) bool) {
+								if !yield("trainJob-node-0-0.trainJob") {
+									return
+								}
+								yield("trainJob-node-0-1.trainJob")
+							},
+							Containers: []runtime.Container{{
+								Name: constants.Node,
+							}},
+						},
+						{
+							Name:     constants.AncestorParameterServer,
+							Ancestor: ptr.To(constants.AncestorParameterServer),
+							Count:    ptr.To[int32](1),
+							Endpoints: func(yield func(string) bool) {
+								yield("trainJob-ps-0-0.trainJob")
+							},
+							Containers: []runtime.Container{{
+								Name: constants.Node,
+							}},
+						},
+					},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Trainer(
+					utiltesting.MakeTrainJobTrainerWrapper().
+						NumNodes(2).
+						Env(corev1.EnvVar{Name: "TEST_ENV", Value: "value"}).
+						Obj()).
+				Obj(),
+			wantInfo: &runtime.Info{
+				Labels:      make(map[string]string),
+				Annotations: make(map[string]string),
+				RuntimePolicy: runtime.RuntimePolicy{
+					MLPolicySource: utiltesting.MakeMLPolicySourceWrapper().
+						TensorFlowPolicy().
+						Obj(),
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{
+						{
+							Name:     constants.AncestorChief,
+							Ancestor: ptr.To(constants.AncestorChief),
+							Count:    ptr.To[int32](1),
+							Containers: []runtime.Container{{
+								Name:  constants.Node,
+								Ports: ports,
+								Env: []corev1ac.EnvVarApplyConfiguration{
+									{
+										Name:  ptr.To("TEST_ENV"),
+										Value: ptr.To("value"),
+									},
+									taskIndexEnv,
+									{
+										Name:  ptr.To(constants.TensorFlowEnvConfig),
+										Value: ptr.To(fmt.Sprintf(`{"cluster":%s,"task":{"type":"chief","index":$(TF_TASK_INDEX)}}`, clusterSpec)),
+									},
+								},
+							}},
+						},
+						{
+							Name:     constants.Node,
+							Ancestor: ptr.To(constants.AncestorTrainer),
+							Count:    ptr.To[int32](2),
+							Containers: []runtime.Container{{
+								Name:  constants.Node,
+								Ports: ports,
+								Env: []corev1ac.EnvVarApplyConfiguration{
+									{
+										Name:  ptr.To("TEST_ENV"),
+										Value: ptr.To("value"),
+									},
+									taskIndexEnv,
+									{
+										Name:  ptr.To(constants.TensorFlowEnvConfig),
+										Value: ptr.To(fmt.Sprintf(`{"cluster":%s,"task":{"type":"worker","index":$(TF_TASK_INDEX)}}`, clusterSpec)),
+									},
+								},
+							}},
+						},
+						{
+							Name:     constants.AncestorParameterServer,
+							Ancestor: ptr.To(constants.AncestorParameterServer),
+							Count:    ptr.To[int32](1),
+							Containers: []runtime.Container{{
+								Name:  constants.Node,
+								Ports: ports,
+								Env: []corev1ac.EnvVarApplyConfiguration{
+									{
+										Name:  ptr.To("TEST_ENV"),
+										Value: ptr.To("value"),
+									},
+									taskIndexEnv,
+									{
+										Name:  ptr.To(constants.TensorFlowEnvConfig),
+										Value: ptr.To(fmt.Sprintf(`{"cluster":%s,"task":{"type":"ps","index":$(TF_TASK_INDEX)}}`, clusterSpec)),
+									},
+								},
+							}},
+						},
+					},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			p, err := New(ctx, utiltesting.NewClientBuilder().Build(), nil)
+			if err != nil {
+				t.Fatalf("Failed to initialize TensorFlow plugin: %v", err)
+			}
+
+			err = p.(framework.EnforceMLPolicyPlugin).EnforceMLPolicy(tc.info, tc.trainJob)
+			if diff := cmp.Diff(tc.wantMLPolicyError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error from EnforceMLPolicy (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantInfo, tc.info,
+				cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+				cmpopts.SortMaps(func(a, b string) bool { return a < b }),
+				cmpopts.IgnoreFields(runtime.PodSet{}, "Endpoints"),
+			); len(diff) != 0 {
+				t.Errorf("Unexpected RuntimeInfo (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tfInfo := runtime.NewInfo(
+		runtime.WithMLPolicySource(utiltesting.MakeMLPolicyWrapper().
+			WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+				TensorFlowPolicy().
+				Obj(),
+			).
+			Obj(),
+		),
+	)
+	cases := map[string]struct {
+		info         *runtime.Info
+		oldObj       *trainer.TrainJob
+		newObj       *trainer.TrainJob
+		wantError    field.ErrorList
+		wantWarnings admission.Warnings
+	}{
+		"no action when info is nil": {
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Obj(),
+		},
+		"no action when info does not have TensorFlow policy": {
+			info: runtime.NewInfo(
+				runtime.WithMLPolicySource(utiltesting.MakeMLPolicyWrapper().Obj()),
+			),
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					NumProcPerNode(intstr.FromInt32(2)).
+					Obj(),
+				).
+				Obj(),
+		},
+		"numProcPerNode is set": {
+			info: tfInfo,
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					NumProcPerNode(intstr.FromInt32(2)).
+					Obj(),
+				).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Forbidden(field.NewPath("spec").Child("trainer").Child("numProcPerNode"), "must not be set for TensorFlow TrainJob"),
+			},
+		},
+		"reserved environment variable present": {
+			info: tfInfo,
+			newObj: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Trainer(utiltesting.MakeTrainJobTrainerWrapper().
+					Env(corev1.EnvVar{Name: constants.TensorFlowEnvConfig, Value: "{}"}).
+					Obj(),
+				).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(
+					field.NewPath("spec").Child("trainer").Child("env"),
+					[]corev1.EnvVar{{Name: constants.TensorFlowEnvConfig, Value: "{}"}},
+					fmt.Sprintf("must not have reserved envs, invalid envs configured: %v", []string{constants.TensorFlowEnvConfig}),
+				),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			p, err := New(ctx, utiltesting.NewClientBuilder().Build(), nil)
+			if err != nil {
+				t.Fatalf("Failed to initialize TensorFlow plugin: %v", err)
+			}
+			warnings, errs := p.(framework.CustomValidationPlugin).Validate(ctx, tc.info, tc.oldObj, tc.newObj)
+			if diff := cmp.Diff(tc.wantError, errs); len(diff) != 0 {
+				t.Errorf("Unexpected error from Validate (-want, +got): %s", diff)
+			}
+			if diff := cmp.Diff(tc.wantWarnings, warnings); len(diff) != 0 {
+				t.Errorf("Unexpected warnings from Validate (-want, +got): %s", diff)
+			}
+		})
+	}
+}
@@ -43,6 +43,7 @@ type Torch struct{}
 
 var _ framework.EnforceMLPolicyPlugin = (*Torch)(nil)
 var _ framework.CustomValidationPlugin = (*Torch)(nil)
+var _ framework.RuntimeValidationPlugin = (*Torch)(nil)
 
 const Name = "Torch"
 
@@ -54,14 +55,44 @@ func (t *Torch) Name() string {
 	return Name
 }
 
+func (t *Torch) ValidateRuntime(_ context.Context, spec *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList) {
+	if spec.MLPolicy == nil || spec.MLPolicy.Torch == nil {
+		return nil, nil
+	}
+	allErrs := runtime.ValidateAncestorContainer(spec, constants.AncestorTrainer, constants.Node, "Torch")
+	// The trainer nodes can not be scaled by the metrics since the JobSet replicatedJobs are immutable.
+	if elasticPolicy := spec.MLPolicy.Torch.ElasticPolicy; elasticPolicy != nil && len(elasticPolicy.Metrics) != 0 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "mlPolicy", "torch", "elasticPolicy", "metrics"),
+			"must not be set since the trainer nodes can not be scaled while the TrainJob is running"))
+	}
+	return nil, allErrs
+}
+
 func (t *Torch) Validate(_ context.Context, runtimeInfo *runtime.Info, _, newObj *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
 	var allErrs field.ErrorList
-	if runtimeInfo == nil || runtimeInfo.RuntimePolicy.MLPolicySource == nil || runtimeInfo.RuntimePolicy.MLPolicySource.Torch == nil || newObj.Spec.Trainer == nil || newObj.Spec.Trainer.NumProcPerNode == nil {
+	if runtimeInfo == nil || runtimeInfo.RuntimePolicy.MLPolicySource == nil || runtimeInfo.RuntimePolicy.MLPolicySource.Torch == nil {
 		return nil, allErrs
 	}
 
 	specPath := field.NewPath("spec")
 
+	// Check the number of nodes for the elastic training.
+	if elasticPolicy := runtimeInfo.RuntimePolicy.MLPolicySource.Torch.ElasticPolicy; elasticPolicy != nil {
+		numNodes := ptr.Deref(ptr.Deref(runtimeInfo.FindPodSetByAncestor(constants.AncestorTrainer), runtime.PodSet{}).Count, 1)
+		if newObj.Spec.Trainer != nil && newObj.Spec.Trainer.NumNodes != nil {
+			numNodes = *newObj.Spec.Trainer.NumNodes
+		}
+		minNodes, maxNodes := elasticNodesRange(elasticPolicy, numNodes)
+		if numNodes < minNodes || numNodes > maxNodes {
+			numNodesPath := specPath.Child("trainer").Child("numNodes")
+			allErrs = append(allErrs, field.Invalid(numNodesPath, numNodes, fmt.Sprintf("must be between minNodes (%d) and maxNodes (%d) of the elasticPolicy", minNodes, maxNodes)))
+		}
+	}
+
+	if newObj.Spec.Trainer == nil || newObj.Spec.Trainer.NumProcPerNode == nil {
+		return nil, allErrs
+	}
+
 	if newObj.Spec.Trainer != nil {
 		numProcPerNodePath := specPath.Child("trainer").Child("numProcPerNode")
 		numProcPerNode := *newObj.Spec.Trainer.NumProcPerNode
@@ -106,7 +137,6 @@ func (t *Torch) Validate(_ context.Context, runtimeInfo *runtime.Info, _, newObj
 	return nil, allErrs
 }
 
-// TODO (andreyvelich): Add support for PyTorch elastic when JobSet supports Elastic Jobs.
 func (t *Torch) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
 	if info == nil || info.RuntimePolicy.MLPolicySource == nil || info.RuntimePolicy.MLPolicySource.Torch == nil {
 		return nil
@@ -118,6 +148,15 @@ func (t *Torch) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob)
 		*trainerPS.Count = *trainJob.Spec.Trainer.NumNodes
 	}
 
+	// The elastic training is performed within the range of nodes, e.g. PET_NNODES=1:4.
+	numNodes := ptr.Deref(ptr.Deref(trainerPS, runtime.PodSet{}).Count, 1)
+	nnodes := fmt.Sprintf("%d", numNodes)
+	elasticPolicy := info.RuntimePolicy.MLPolicySource.Torch.ElasticPolicy
+	if elasticPolicy != nil {
+		minNodes, maxNodes := elasticNodesRange(elasticPolicy, numNodes)
+		nnodes = fmt.Sprintf("%d:%d", minNodes, maxNodes)
+	}
+
 	numProcPerNode := ptr.Deref(info.RuntimePolicy.MLPolicySource.Torch.NumProcPerNode, intstr.FromString("auto"))
 	if trainJob.Spec.Trainer != nil && trainJob.Spec.Trainer.NumProcPerNode != nil {
 		numProcPerNode = ptr.Deref(trainJob.Spec.Trainer.NumProcPerNode, intstr.FromString("auto"))
@@ -164,29 +203,61 @@ func (t *Torch) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob)
 		}
 	}
 	if trainerContainer != nil {
+		// The first Pod of the trainer PodSet is the master node.
+		var masterAddr string
+		if trainerPS != nil {
+			masterAddr = trainerPS.FirstEndpoint()
+		}
+
 		// Add PyTorch distributed "PET_" values for torchrun and torchtune.
 		// TODO (andreyvelich): We should validate that envs from different plugins don't conflict with each other.
 		// Ref: https://github.com/kubeflow/trainer/pull/2308#discussion_r1823229940
 		apply.UpsertEnvVar(&trainerContainer.Env,
 			*corev1ac.EnvVar().
 				WithName(constants.TorchEnvNumNodes).
-				WithValue(fmt.Sprintf("%d", ptr.Deref(ptr.Deref(trainerPS, runtime.PodSet{}).Count, 1))),
+				WithValue(nnodes),
 			*corev1ac.EnvVar().
 				WithName(constants.TorchEnvNumProcPerNode).
 				WithValue(numProcPerNode.String()),
-			*corev1ac.EnvVar().
-				WithName(constants.TorchEnvNodeRank).
-				WithValueFrom(corev1ac.EnvVarSource().
-					WithFieldRef(corev1ac.ObjectFieldSelector().
-						WithFieldPath(constants.JobCompletionIndexFieldPath))),
 		)
 
-		if !slices.Equal(trainJob.Spec.Trainer.Command, constants.TorchTuneEntrypoint) {
+		// The Job completion index is unique only within a single replicated Job, and the number of nodes
+		// is changed during the elastic training. So, the node rank is assigned by the c10d rendezvous
+		// when the trainer has multiple replicated Jobs or the elastic policy is configured.
+		isTorchTune := slices.Equal(trainJob.Spec.Trainer.Command, constants.TorchTuneEntrypoint)
+		multiReplicas := ptr.Deref(ptr.Deref(trainerPS, runtime.PodSet{}).Replicas, constants.DefaultJobReplicas) > 1
+		if (multiReplicas || elasticPolicy != nil) && !isTorchTune {
+			apply.UpsertEnvVar(&trainerContainer.Env,
+				*corev1ac.EnvVar().
+					WithName(constants.TorchEnvRdzvBackend).
+					WithValue(constants.TorchRdzvBackendC10d),
+				*corev1ac.EnvVar().
+					WithName(constants.TorchEnvRdzvEndpoint).
+					WithValue(fmt.Sprintf("%s:%d", masterAddr, constants.ContainerTrainerPort)),
+			)
+			if elasticPolicy != nil && elasticPolicy.MaxRestarts != nil {
+				apply.UpsertEnvVar(&trainerContainer.Env,
+					*corev1ac.EnvVar().
+						WithName(constants.TorchEnvMaxRestarts).
+						WithValue(fmt.Sprintf("%d", *elasticPolicy.MaxRestarts)),
+				)
+			}
+		} else {
+			apply.UpsertEnvVar(&trainerContainer.Env,
+				*corev1ac.EnvVar().
+					WithName(constants.TorchEnvNodeRank).
+					WithValueFrom(corev1ac.EnvVarSource().
+						WithFieldRef(corev1ac.ObjectFieldSelector().
+							WithFieldPath(constants.JobCompletionIndexFieldPath))),
+			)
+		}
+
+		if !isTorchTune {
 			// Add PET_MASTER_ADDR and PET_MASTER_PORT envs for torchrun.
 			apply.UpsertEnvVar(&trainerContainer.Env,
 				*corev1ac.EnvVar().
 					WithName(constants.TorchEnvMasterAddr).
-					WithValue(fmt.Sprintf("%s-%s-0-0.%s", trainJob.Name, constants.Node, trainJob.Name)),
+					WithValue(masterAddr),
 				*corev1ac.EnvVar().
 					WithName(constants.TorchEnvMasterPort).
 					WithValue(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
@@ -200,10 +271,7 @@ func (t *Torch) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob)
 			numNodes := ptr.Deref(ptr.Deref(trainerPS, runtime.PodSet{}).Count, 1)
 			if numNodes > 1 || !(numProcPerNode.Type == intstr.Int && numProcPerNode.IntVal == 1) {
 				newCommand = append(newCommand,
-					fmt.Sprintf("%s=%s-%s-0-0.%s:%d",
-						constants.TorchTuneArgRdzvEndpoint,
-						trainJob.Name, constants.Node, trainJob.Name, constants.ContainerTrainerPort,
-					),
+					fmt.Sprintf("%s=%s:%d", constants.TorchTuneArgRdzvEndpoint, masterAddr, constants.ContainerTrainerPort),
 				)
 			}
 
@@ -228,6 +296,12 @@ func (t *Torch) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob)
 	return nil
 }
 
+// elasticNodesRange returns the min and max nodes for the elastic training.
+// The numNodes is used when the min or max nodes is not specified.
+func elasticNodesRange(elasticPolicy *trainer.TorchElasticPolicy, numNodes int32) (int32, int32) {
+	return ptr.Deref(elasticPolicy.MinNodes, numNodes), ptr.Deref(elasticPolicy.MaxNodes, numNodes)
+}
+
 // calculateNumProcPerNode calculates the number of processes per node based on the provided resources.
 // It returns the calculated number of processes per node and a boolean indicating whether CPU resources were used.
 func calculateNumProcPerNode(
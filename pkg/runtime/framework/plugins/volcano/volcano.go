@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volcano
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	volcanov1beta1ac "volcano.sh/apis/pkg/client/applyconfiguration/scheduling/v1beta1"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+)
+
+type Volcano struct {
+	client client.Client
+}
+
+var _ framework.EnforcePodGroupPolicyPlugin = (*Volcano)(nil)
+var _ framework.WatchExtensionPlugin = (*Volcano)(nil)
+var _ framework.ComponentBuilderPlugin = (*Volcano)(nil)
+var _ framework.RuntimeValidationPlugin = (*Volcano)(nil)
+
+var (
+	ErrorVolcanoPodGroupCRDNotInstalled = errors.New("PodGroup CRDs for Volcano must be installed in advance")
+)
+
+const Name = "Volcano"
+
+// +kubebuilder:rbac:groups=scheduling.volcano.sh,resources=podgroups,verbs=create;get;list;watch;update;patch
+
+func New(_ context.Context, client client.Client, _ client.FieldIndexer) (framework.Plugin, error) {
+	if _, err := client.RESTMapper().RESTMapping(
+		schema.GroupKind{Group: volcanov1beta1.SchemeGroupVersion.Group, Kind: "PodGroup"},
+		volcanov1beta1.SchemeGroupVersion.Version,
+	); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrorVolcanoPodGroupCRDNotInstalled, err)
+	}
+	return &Volcano{
+		client: client,
+	}, nil
+}
+
+func (v *Volcano) Name() string {
+	return Name
+}
+
+// ValidateRuntime rejects the JobSet InOrder startupPolicy since JobSet creates the next replicatedJob only once the
+// previous ones are ready, while the Volcano PodGroup doesn't schedule any Pods until all members are created.
+func (v *Volcano) ValidateRuntime(_ context.Context, spec *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList) {
+	if spec.PodGroupPolicy == nil || spec.PodGroupPolicy.Volcano == nil {
+		return nil, nil
+	}
+	if startupPolicy := spec.Template.Spec.StartupPolicy; startupPolicy != nil && startupPolicy.StartupPolicyOrder == jobsetv1alpha2.InOrder {
+		return nil, field.ErrorList{
+			field.Forbidden(field.NewPath("spec", "template", "spec", "startupPolicy", "startupPolicyOrder"),
+				fmt.Sprintf("must not be %s for the Volcano podGroupPolicy", jobsetv1alpha2.InOrder)),
+		}
+	}
+	return nil, nil
+}
+
+func (v *Volcano) EnforcePodGroupPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
+	if info == nil || info.RuntimePolicy.PodGroupPolicy == nil || info.RuntimePolicy.PodGroupPolicy.Volcano == nil || trainJob == nil {
+		return nil
+	}
+
+	if info.Scheduler.PodAnnotations == nil {
+		info.Scheduler.PodAnnotations = make(map[string]string, 2)
+	}
+	info.Scheduler.PodAnnotations[volcanov1beta1.KubeGroupNameAnnotationKey] = trainJob.Name
+	if queue := info.RuntimePolicy.PodGroupPolicy.Volcano.Queue; queue != nil {
+		info.Scheduler.PodAnnotations[volcanov1beta1.QueueNameAnnotationKey] = *queue
+	}
+	return nil
+}
+
+func (v *Volcano) Build(ctx context.Context, info *runtime.Info, trainJob *trainer.TrainJob) ([]any, error) {
+	if info == nil || info.RuntimePolicy.PodGroupPolicy == nil || info.RuntimePolicy.PodGroupPolicy.Volcano == nil || trainJob == nil {
+		return nil, nil
+	}
+
+	// Do not update the PodGroup if it already exists and the TrainJob is not suspended
+	oldPodGroup := &volcanov1beta1.PodGroup{}
+	if err := v.client.Get(ctx, client.ObjectKeyFromObject(trainJob), oldPodGroup); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		oldPodGroup = nil
+	}
+	if oldPodGroup != nil && !ptr.Deref(trainJob.Spec.Suspend, false) {
+		return nil, nil
+	}
+
+	totalMembers, totalResources := info.TotalPodsAndRequests()
+	volcanoPolicy := info.RuntimePolicy.PodGroupPolicy.Volcano
+
+	podGroupSpec := volcanov1beta1ac.PodGroupSpec().
+		WithMinMember(totalMembers).
+		WithMinResources(totalResources)
+	if volcanoPolicy.Queue != nil {
+		podGroupSpec.WithQueue(*volcanoPolicy.Queue)
+	}
+	if volcanoPolicy.PriorityClassName != nil {
+		podGroupSpec.WithPriorityClassName(*volcanoPolicy.PriorityClassName)
+	}
+
+	podGroup := volcanov1beta1ac.PodGroup(trainJob.Name, trainJob.Namespace).
+		WithSpec(podGroupSpec).
+		WithOwnerReferences(metav1ac.OwnerReference().
+			WithAPIVersion(trainer.GroupVersion.String()).
+			WithKind(trainer.TrainJobKind).
+			WithName(trainJob.Name).
+			WithUID(trainJob.UID).
+			WithController(true).
+			WithBlockOwnerDeletion(true))
+
+	return []any{podGroup}, nil
+}
+
+func (v *Volcano) ReconcilerBuilders() []runtime.ReconcilerBuilder {
+	return []runtime.ReconcilerBuilder{
+		func(b *builder.Builder, cl client.Client, cache cache.Cache) *builder.Builder {
+			return b.Watches(
+				&volcanov1beta1.PodGroup{},
+				handler.EnqueueRequestForOwner(
+					v.client.Scheme(), v.client.RESTMapper(), &trainer.TrainJob{}, handler.OnlyControllerOwner(),
+				),
+			)
+		},
+	}
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volcano
+
+import (
+	"context"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2/ktesting"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+func TestVolcano(t *testing.T) {
+	cases := map[string]struct {
+		info     *runtime.Info
+		trainJob *trainer.TrainJob
+		objs     []client.Object
+		wantInfo *runtime.Info
+		wantObjs []apiruntime.Object
+	}{
+		"no action when info is nil": {
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").Obj(),
+		},
+		"no action when podGroupPolicy volcano is null": {
+			info: runtime.NewInfo(
+				runtime.WithPodGroupPolicy(&trainer.PodGroupPolicy{
+					PodGroupPolicySource: trainer.PodGroupPolicySource{
+						Coscheduling: &trainer.CoschedulingPodGroupPolicySource{ScheduleTimeoutSeconds: ptr.To[int32](60)},
+					},
+				}),
+			),
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").Obj(),
+			wantInfo: runtime.NewInfo(
+				runtime.WithPodGroupPolicy(&trainer.PodGroupPolicy{
+					PodGroupPolicySource: trainer.PodGroupPolicySource{
+						Coscheduling: &trainer.CoschedulingPodGroupPolicySource{ScheduleTimeoutSeconds: ptr.To[int32](60)},
+					},
+				}),
+			),
+		},
+		"PodGroup is built with queue and priorityClassName": {
+			info: &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					PodGroupPolicy: &trainer.PodGroupPolicy{
+						PodGroupPolicySource: trainer.PodGroupPolicySource{
+							Volcano: &trainer.VolcanoPodGroupPolicySource{
+								Queue:             ptr.To("research"),
+								PriorityClassName: ptr.To("high-priority"),
+							},
+						},
+					},
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{
+						{
+							Name:  constants.Launcher,
+							Count: ptr.To[int32](1),
+							SinglePodRequests: corev1.ResourceList{
+								corev1.ResourceCPU: resource.MustParse("1"),
+							},
+						},
+						{
+							Name:  constants.Node,
+							Count: ptr.To[int32](2),
+							SinglePodRequests: corev1.ResourceList{
+								corev1.ResourceCPU: resource.MustParse("2"),
+							},
+						},
+					},
+				},
+				Scheduler: &runtime.Scheduler{PodLabels: make(map[string]string)},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				UID("trainJob").
+				Obj(),
+			wantInfo: &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					PodGroupPolicy: &trainer.PodGroupPolicy{
+						PodGroupPolicySource: trainer.PodGroupPolicySource{
+							Volcano: &trainer.VolcanoPodGroupPolicySource{
+								Queue:             ptr.To("research"),
+								PriorityClassName: ptr.To("high-priority"),
+							},
+						},
+					},
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{
+						{
+							Name:  constants.Launcher,
+							Count: ptr.To[int32](1),
+							SinglePodRequests: corev1.ResourceList{
+								corev1.ResourceCPU: resource.MustParse("1"),
+							},
+						},
+						{
+							Name:  constants.Node,
+							Count: ptr.To[int32](2),
+							SinglePodRequests: corev1.ResourceList{
+								corev1.ResourceCPU: resource.MustParse("2"),
+							},
+						},
+					},
+				},
+				Scheduler: &runtime.Scheduler{
+					PodLabels: make(map[string]string),
+					PodAnnotations: map[string]string{
+						volcanov1beta1.KubeGroupNameAnnotationKey: "trainJob",
+						volcanov1beta1.QueueNameAnnotationKey:     "research",
+					},
+				},
+			},
+			wantObjs: []apiruntime.Object{
+				utiltesting.MakeVolcanoPodGroup(metav1.NamespaceDefault, "trainJob").
+					ControllerReference(trainer.SchemeGroupVersion.WithKind(trainer.TrainJobKind), "trainJob", "trainJob").
+					MinMember(3).
+					MinResources(corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("5"),
+					}).
+					Queue("research").
+					PriorityClassName("high-priority").
+					Obj(),
+			},
+		},
+		"PodGroup is not updated when it already exists and TrainJob is not suspended": {
+			objs: []client.Object{
+				utiltesting.MakeVolcanoPodGroup(metav1.NamespaceDefault, "trainJob").
+					MinMember(1).
+					Obj(),
+			},
+			info: &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					PodGroupPolicy: &trainer.PodGroupPolicy{
+						PodGroupPolicySource: trainer.PodGroupPolicySource{
+							Volcano: &trainer.VolcanoPodGroupPolicySource{},
+						},
+					},
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:  constants.Node,
+						Count: ptr.To[int32](2),
+					}},
+				},
+				Scheduler: &runtime.Scheduler{},
+			},
+			trainJob: utiltesting.MakeTrainJobWrapper(metav1.NamespaceDefault, "trainJob").
+				Suspend(false).
+				Obj(),
+			wantInfo: &runtime.Info{
+				RuntimePolicy: runtime.RuntimePolicy{
+					PodGroupPolicy: &trainer.PodGroupPolicy{
+						PodGroupPolicySource: trainer.PodGroupPolicySource{
+							Volcano: &trainer.VolcanoPodGroupPolicySource{},
+						},
+					},
+				},
+				TemplateSpec: runtime.TemplateSpec{
+					PodSets: []runtime.PodSet{{
+						Name:  constants.Node,
+						Count: ptr.To[int32](2),
+					}},
+				},
+				Scheduler: &runtime.Scheduler{
+					PodAnnotations: map[string]string{
+						volcanov1beta1.KubeGroupNameAnnotationKey: "trainJob",
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			var cancel func()
+			ctx, cancel = context.WithCancel(ctx)
+			t.Cleanup(cancel)
+			cli := utiltesting.NewClientBuilder().WithObjects(tc.objs...).Build()
+
+			p, err := New(ctx, cli, nil)
+			if err != nil {
+				t.Fatalf("Failed to initialize Volcano plugin: %v", err)
+			}
+			if err = p.(framework.EnforcePodGroupPolicyPlugin).EnforcePodGroupPolicy(tc.info, tc.trainJob); err != nil {
+				t.Errorf("Unexpected error from EnforcePodGroupPolicy: %v", err)
+			}
+			if diff := gocmp.Diff(tc.wantInfo, tc.info, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Unexpected info from EnforcePodGroupPolicy (-want, +got): %s", diff)
+			}
+			objs, err := p.(framework.ComponentBuilderPlugin).Build(ctx, tc.info, tc.trainJob)
+			if err != nil {
+				t.Errorf("Unexpected error from Build: %v", err)
+			}
+			typedObjs, err := utiltesting.ToObject(cli.Scheme(), objs...)
+			if err != nil {
+				t.Errorf("Failed to convert object: %v", err)
+			}
+			if diff := gocmp.Diff(tc.wantObjs, typedObjs); len(diff) != 0 {
+				t.Errorf("Unexpected objects from Build (-want, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	cases := map[string]struct {
+		restMapper meta.RESTMapper
+		wantErr    error
+	}{
+		"Volcano PodGroup CRDs are installed": {},
+		"Volcano PodGroup CRDs are not installed": {
+			restMapper: meta.NewDefaultRESTMapper(nil),
+			wantErr:    ErrorVolcanoPodGroupCRDNotInstalled,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			clientBuilder := utiltesting.NewClientBuilder()
+			if tc.restMapper != nil {
+				clientBuilder = clientBuilder.WithRESTMapper(tc.restMapper)
+			}
+			_, err := New(ctx, clientBuilder.Build(), nil)
+			if diff := gocmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error from New (-want, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateRuntime(t *testing.T) {
+	volcanoRuntimeSpec := func(startupPolicyOrder jobsetv1alpha2.StartupPolicyOptions) *trainer.TrainingRuntimeSpec {
+		spec := utiltesting.MakeTrainingRuntimeSpecWrapper(utiltesting.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "test").Spec).
+			PodGroupPolicyVolcano(&trainer.VolcanoPodGroupPolicySource{}).
+			Obj()
+		spec.Template.Spec.StartupPolicy = &jobsetv1alpha2.StartupPolicy{StartupPolicyOrder: startupPolicyOrder}
+		return &spec
+	}
+	cases := map[string]struct {
+		spec         *trainer.TrainingRuntimeSpec
+		wantError    field.ErrorList
+		wantWarnings admission.Warnings
+	}{
+		"no action when runtime does not have Volcano podGroupPolicy": {
+			spec: func() *trainer.TrainingRuntimeSpec {
+				spec := volcanoRuntimeSpec(jobsetv1alpha2.InOrder)
+				spec.PodGroupPolicy = nil
+				return spec
+			}(),
+		},
+		"runtime has AnyOrder startupPolicy": {
+			spec: volcanoRuntimeSpec(jobsetv1alpha2.AnyOrder),
+		},
+		"runtime has InOrder startupPolicy": {
+			spec: volcanoRuntimeSpec(jobsetv1alpha2.InOrder),
+			wantError: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "template", "spec", "startupPolicy", "startupPolicyOrder"),
+					"must not be InOrder for the Volcano podGroupPolicy"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			p, err := New(ctx, utiltesting.NewClientBuilder().Build(), nil)
+			if err != nil {
+				t.Fatalf("Failed to initialize Volcano plugin: %v", err)
+			}
+			warnings, errs := p.(framework.RuntimeValidationPlugin).ValidateRuntime(ctx, tc.spec)
+			if diff := gocmp.Diff(tc.wantError, errs); len(diff) != 0 {
+				t.Errorf("Unexpected error from ValidateRuntime (-want, +got): %s", diff)
+			}
+			if diff := gocmp.Diff(tc.wantWarnings, warnings); len(diff) != 0 {
+				t.Errorf("Unexpected warnings from ValidateRuntime (-want, +got): %s", diff)
+			}
+		})
+	}
+}
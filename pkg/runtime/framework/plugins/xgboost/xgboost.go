@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xgboost
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/apply"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	"github.com/kubeflow/trainer/v2/pkg/runtime/framework"
+)
+
+type XGBoost struct{}
+
+var _ framework.EnforceMLPolicyPlugin = (*XGBoost)(nil)
+var _ framework.CustomValidationPlugin = (*XGBoost)(nil)
+var _ framework.RuntimeValidationPlugin = (*XGBoost)(nil)
+
+const Name = "XGBoost"
+
+func New(context.Context, client.Client, client.FieldIndexer) (framework.Plugin, error) {
+	return &XGBoost{}, nil
+}
+
+func (x *XGBoost) Name() string {
+	return Name
+}
+
+func (x *XGBoost) ValidateRuntime(_ context.Context, spec *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList) {
+	if spec.MLPolicy == nil || spec.MLPolicy.XGBoost == nil {
+		return nil, nil
+	}
+	return nil, runtime.ValidateAncestorContainer(spec, constants.AncestorTrainer, constants.Node, "XGBoost")
+}
+
+func (x *XGBoost) Validate(_ context.Context, runtimeInfo *runtime.Info, _, newObj *trainer.TrainJob) (admission.Warnings, field.ErrorList) {
+	var allErrs field.ErrorList
+	if runtimeInfo == nil || runtimeInfo.RuntimePolicy.MLPolicySource == nil || runtimeInfo.RuntimePolicy.MLPolicySource.XGBoost == nil || newObj.Spec.Trainer == nil {
+		return nil, allErrs
+	}
+
+	// Check reserved envs.
+	xgboostEnvs := sets.New[string]()
+	for _, env := range newObj.Spec.Trainer.Env {
+		if constants.XGBoostReservedEnvNames.Has(env.Name) {
+			xgboostEnvs.Insert(env.Name)
+		}
+	}
+	if xgboostEnvs.Len() > 0 {
+		trainerEnvsPath := field.NewPath("spec").Child("trainer").Child("env")
+		allErrs = append(allErrs, field.Invalid(trainerEnvsPath, newObj.Spec.Trainer.Env, fmt.Sprintf("must not have reserved envs, invalid envs configured: %v", sets.List(xgboostEnvs))))
+	}
+	return nil, allErrs
+}
+
+func (x *XGBoost) EnforceMLPolicy(info *runtime.Info, trainJob *trainer.TrainJob) error {
+	if info == nil || info.RuntimePolicy.MLPolicySource == nil || info.RuntimePolicy.MLPolicySource.XGBoost == nil {
+		return nil
+	}
+
+	// TrainJob contains the actual information for the Trainer.
+	trainerPS := info.FindPodSetByAncestor(constants.AncestorTrainer)
+	if trainerPS == nil {
+		return nil
+	}
+	if trainerPS.Count != nil && trainJob.Spec.Trainer != nil && trainJob.Spec.Trainer.NumNodes != nil {
+		*trainerPS.Count = *trainJob.Spec.Trainer.NumNodes
+	}
+
+	// Update envs for Info object.
+	trainerContainer := info.FindContainerByPodSetAncestorContainerName(constants.AncestorTrainer, constants.Node)
+	if trainerContainer == nil {
+		return nil
+	}
+	if trainJob.Spec.Trainer != nil {
+		apply.UpsertEnvVars(&trainerContainer.Env, apply.EnvVars(trainJob.Spec.Trainer.Env...)...)
+	}
+
+	// Add DMLC envs for the Rabit tracker.
+	// The rank 0 node runs the tracker, and all workers connect to it.
+	apply.UpsertEnvVar(&trainerContainer.Env,
+		*corev1ac.EnvVar().
+			WithName(constants.XGBoostEnvTrackerURI).
+			WithValue(trainerPS.FirstEndpoint()),
+		*corev1ac.EnvVar().
+			WithName(constants.XGBoostEnvTrackerPort).
+			WithValue(fmt.Sprintf("%d", constants.ContainerTrainerPort)),
+		*corev1ac.EnvVar().
+			WithName(constants.XGBoostEnvNumWorker).
+			WithValue(fmt.Sprintf("%d", ptr.Deref(trainerPS.Count, 1))),
+		*corev1ac.EnvVar().
+			WithName(constants.XGBoostEnvTaskID).
+			WithValueFrom(corev1ac.EnvVarSource().
+				WithFieldRef(corev1ac.ObjectFieldSelector().
+					WithFieldPath(constants.JobCompletionIndexFieldPath))),
+	)
+
+	// Add container port for the headless service.
+	apply.UpsertPort(&trainerContainer.Ports, *corev1ac.ContainerPort().WithContainerPort(constants.ContainerTrainerPort))
+
+	info.SyncPodSetsToTemplateSpec()
+	return nil
+}
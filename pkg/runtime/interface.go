@@ -38,6 +38,14 @@ type Runtime interface {
 
 	NewObjects(ctx context.Context, trainJob *trainer.TrainJob) ([]any, error)
 	TerminalCondition(ctx context.Context, trainJob *trainer.TrainJob) (*metav1.Condition, error)
+	RunningCondition(ctx context.Context, trainJob *trainer.TrainJob) (*metav1.Condition, error)
+	// JobsStatus returns the status of the TrainJob Jobs and the number of times
+	// the TrainJob resources have been restarted by the failure policy.
+	JobsStatus(ctx context.Context, trainJob *trainer.TrainJob) ([]trainer.JobStatus, *int32, error)
 	EventHandlerRegistrars() []ReconcilerBuilder
 	ValidateObjects(ctx context.Context, old, new *trainer.TrainJob) (admission.Warnings, field.ErrorList)
+	// ValidateRuntime validates the TrainingRuntime and ClusterTrainingRuntime spec is structurally compatible
+	// with the MLPolicy and PodGroupPolicy before any TrainJob is built from it.
+	ValidateRuntime(ctx context.Context, spec *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList)
+	DefaultObjects(ctx context.Context, trainJob *trainer.TrainJob) error
 }
@@ -17,20 +17,33 @@ limitations under the License.
 package runtime
 
 import (
+	"context"
+	"fmt"
 	"iter"
 	"maps"
 	"slices"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
 	resourcehelpers "k8s.io/component-helpers/resource"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
 )
 
+const rJobReplicasErrorMsg = "always must be 1"
+
 var (
+	// ReplicatedJobsPath is the path to the replicatedJobs in the TrainingRuntime and ClusterTrainingRuntime.
+	ReplicatedJobsPath = field.NewPath("spec").Child("template").Child("spec").Child("replicatedJobs")
+
 	defaultPodSetsSyncer = func(*Info) {}
 	syncPodSets          = defaultPodSetsSyncer
 )
@@ -67,7 +80,13 @@ type PodSet struct {
 	Name string
 	// Ancestor is built by `trainer.kubeflow.org/trainjob-ancestor-step` label value
 	// in Runtime CRDs.
-	Ancestor       *string
+	Ancestor *string
+	// Replicas is the number of replicated Jobs, which is typically stored in
+	// '.template.spec.replicatedJobs[*].replicas'.
+	Replicas *int32
+	// Count is the total number of Pods across all Replicas.
+	// The number of Pods in each replicated Job can be calculated with
+	// Count / Replicas.
 	Count          *int32
 	InitContainers []Container
 	Containers     []Container
@@ -78,6 +97,23 @@ type PodSet struct {
 	SinglePodRequests corev1.ResourceList
 }
 
+// Parallelism returns the number of Pods in each replicated Job.
+func (ps *PodSet) Parallelism() int32 {
+	return max(ptr.Deref(ps.Count, 1)/max(ptr.Deref(ps.Replicas, 1), 1), 1)
+}
+
+// FirstEndpoint returns the endpoint of the first Pod in the PodSet.
+// It returns an empty string when the Pod network is not identified.
+func (ps *PodSet) FirstEndpoint() string {
+	if ps.Endpoints == nil {
+		return ""
+	}
+	for e := range ps.Endpoints {
+		return e
+	}
+	return ""
+}
+
 type Container struct {
 	Name         string
 	Env          []corev1ac.EnvVarApplyConfiguration
@@ -87,7 +123,8 @@ type Container struct {
 
 // TODO (andreyvelich): Potentially, we can add ScheduleTimeoutSeconds to the Scheduler for consistency.
 type Scheduler struct {
-	PodLabels map[string]string
+	PodLabels      map[string]string
+	PodAnnotations map[string]string
 }
 
 type InfoOptions struct {
@@ -134,14 +171,16 @@ func WithTemplateSpecObjApply(objApply any) InfoOption {
 }
 
 // WithPodSet construct Info.TemplateSpec.PodSet from PodSpec.
-// The forth argument, 'typedPodSpec' is used only to calculate requested resources.
+// The 'count' argument is the total number of Pods across all 'replicas'.
+// The fifth argument, 'typedPodSpec' is used only to calculate requested resources.
 func WithPodSet(
-	psName string, ancestor *string, count int32, typedPodSpec corev1.PodSpec, podSpecApply *corev1ac.PodSpecApplyConfiguration,
+	psName string, ancestor *string, replicas, count int32, typedPodSpec corev1.PodSpec, podSpecApply *corev1ac.PodSpecApplyConfiguration,
 ) InfoOption {
 	return func(o *InfoOptions) {
 		ps := PodSet{
 			Name:              psName,
 			Ancestor:          ancestor,
+			Replicas:          ptr.To(max(replicas, 1)),
 			Count:             ptr.To(max(count, 1)),
 			Volumes:           podSpecApply.Volumes,
 			SinglePodRequests: resourcehelpers.PodRequests(&corev1.Pod{Spec: typedPodSpec}, resourcehelpers.PodResourcesOptions{}),
@@ -233,9 +272,126 @@ func (i *Info) FindPodSetByName(psName string) *PodSet {
 	return nil
 }
 
+// TotalPodsAndRequests returns the total number of Pods and the total resource requests across all PodSets.
+// Those are used as the minMember and minResources for the gang-scheduling PodGroup.
+func (i *Info) TotalPodsAndRequests() (int32, corev1.ResourceList) {
+	var totalPods int32
+	totalRequests := make(corev1.ResourceList)
+	for _, ps := range i.TemplateSpec.PodSets {
+		count := ptr.Deref(ps.Count, 1)
+		totalPods += count
+		for resName, quantity := range ps.SinglePodRequests {
+			quantity.Mul(int64(count))
+			current := totalRequests[resName]
+			current.Add(quantity)
+			totalRequests[resName] = current
+		}
+	}
+	return totalPods, totalRequests
+}
+
 func RuntimeRefToRuntimeRegistryKey(runtimeRef trainer.RuntimeRef) string {
 	return schema.GroupKind{
 		Group: ptr.Deref(runtimeRef.APIGroup, ""),
 		Kind:  ptr.Deref(runtimeRef.Kind, ""),
 	}.String()
 }
+
+// ValidateAncestorContainer validates that the runtime template has the replicatedJob with the ancestor label,
+// and that the replicatedJob has the container required by the mlPolicy.
+func ValidateAncestorContainer(spec *trainer.TrainingRuntimeSpec, ancestor, containerName, mlPolicy string) field.ErrorList {
+	for idx, rJob := range spec.Template.Spec.ReplicatedJobs {
+		if rJob.Template.Labels[constants.LabelTrainJobAncestor] != ancestor {
+			continue
+		}
+		for _, container := range rJob.Template.Spec.Template.Spec.Containers {
+			if container.Name == containerName {
+				return nil
+			}
+		}
+		containersPath := ReplicatedJobsPath.Index(idx).Child("template", "spec", "template", "spec", "containers")
+		return field.ErrorList{
+			field.Required(containersPath, fmt.Sprintf("must have the %s container for the %s mlPolicy", containerName, mlPolicy)),
+		}
+	}
+	return field.ErrorList{
+		field.Required(ReplicatedJobsPath, fmt.Sprintf("must have the replicatedJob with the %s ancestor for the %s mlPolicy", ancestor, mlPolicy)),
+	}
+}
+
+// ValidateReplicatedJob validates that the runtime template has the replicatedJob required by the mlPolicy.
+func ValidateReplicatedJob(spec *trainer.TrainingRuntimeSpec, rJobName, mlPolicy string) field.ErrorList {
+	for _, rJob := range spec.Template.Spec.ReplicatedJobs {
+		if rJob.Name == rJobName {
+			return nil
+		}
+	}
+	return field.ErrorList{
+		field.Required(ReplicatedJobsPath, fmt.Sprintf("must have the %s replicatedJob for the %s mlPolicy", rJobName, mlPolicy)),
+	}
+}
+
+// DeprecationMessage returns the message describing the deprecated runtime and its replacement.
+func DeprecationMessage(kind, name string, deprecation *trainer.RuntimeDeprecation) string {
+	message := fmt.Sprintf("%s %q is deprecated", kind, name)
+	if replacedBy := deprecation.ReplacedBy; replacedBy != nil {
+		message += fmt.Sprintf(", use %s %q instead", ptr.Deref(replacedBy.Kind, trainer.ClusterTrainingRuntimeKind), replacedBy.Name)
+	}
+	if deprecation.RejectAfter != nil {
+		message += fmt.Sprintf("; new TrainJobs are rejected after %s", deprecation.RejectAfter.UTC().Format(time.RFC3339))
+	}
+	return message
+}
+
+// ValidateRuntimeSpec validates the TrainingRuntime and ClusterTrainingRuntime spec.
+// It is the single entry point shared by the runtime webhooks and the runtime controllers.
+// The RuntimeValidation plugins are run only when the runtime is given.
+func ValidateRuntimeSpec(ctx context.Context, runtime Runtime, spec *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList) {
+	allErrs := ValidateReplicatedJobs(spec.Template.Spec.ReplicatedJobs, spec.MLPolicy)
+	if runtime == nil {
+		return nil, allErrs
+	}
+	warnings, errs := runtime.ValidateRuntime(ctx, spec)
+	return warnings, append(allErrs, errs...)
+}
+
+// ValidateReplicatedJobs validates the replicatedJobs replicas against the MLPolicy.
+func ValidateReplicatedJobs(rJobs []jobsetv1alpha2.ReplicatedJob, mlPolicy *trainer.MLPolicy) field.ErrorList {
+	var allErrs field.ErrorList
+	for idx, rJob := range rJobs {
+		if rJob.Name == constants.Launcher && rJob.Replicas != 1 {
+			allErrs = append(allErrs, field.Invalid(ReplicatedJobsPath.Index(idx).Child("replicas"), rJob.Replicas, rJobReplicasErrorMsg))
+		}
+
+		if rJob.Template.Labels == nil {
+			continue
+		}
+
+		labelAncestor, ok := rJob.Template.Labels[constants.LabelTrainJobAncestor]
+		if !ok || rJob.Replicas <= 1 || mlPolicy == nil {
+			continue
+		}
+
+		// The JAX process ID, the XGBoost task ID, and the TensorFlow task index are assigned by the Job completion index
+		// which is unique only within a single replicated Job.
+		if mlPolicy.JAX != nil && labelAncestor == constants.AncestorTrainer {
+			allErrs = append(allErrs, field.Invalid(ReplicatedJobsPath.Index(idx).Child("replicas"), rJob.Replicas, "must be 1 for the JAX mlPolicy"))
+		}
+		if mlPolicy.XGBoost != nil && labelAncestor == constants.AncestorTrainer {
+			allErrs = append(allErrs, field.Invalid(ReplicatedJobsPath.Index(idx).Child("replicas"), rJob.Replicas, "must be 1 for the XGBoost mlPolicy"))
+		}
+		if mlPolicy.TensorFlow != nil && sets.New(constants.AncestorChief, constants.AncestorTrainer, constants.AncestorParameterServer).Has(labelAncestor) {
+			allErrs = append(allErrs, field.Invalid(ReplicatedJobsPath.Index(idx).Child("replicas"), rJob.Replicas, "must be 1 for the TensorFlow mlPolicy"))
+		}
+		if labelAncestor != constants.AncestorTrainer {
+			continue
+		}
+
+		// The trainer nodes are evenly distributed across all replicated Jobs.
+		if mlPolicy.NumNodes != nil && *mlPolicy.NumNodes%rJob.Replicas != 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("mlPolicy").Child("numNodes"), *mlPolicy.NumNodes,
+				fmt.Sprintf("must be a multiple of the %s replicatedJob replicas: %d", rJob.Name, rJob.Replicas)))
+		}
+	}
+	return allErrs
+}
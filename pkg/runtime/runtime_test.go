@@ -23,13 +23,17 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	batchv1ac "k8s.io/client-go/applyconfigurations/batch/v1"
 	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
 	"k8s.io/utils/ptr"
+	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	jobsetv1alpha2ac "sigs.k8s.io/jobset/client-go/applyconfiguration/jobset/v1alpha2"
 
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
 	jobsetplgconsts "github.com/kubeflow/trainer/v2/pkg/runtime/framework/plugins/jobset/constants"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
 )
 
 func TestNewInfo(t *testing.T) {
@@ -45,7 +49,7 @@ func TestNewInfo(t *testing.T) {
 				WithAnnotations(map[string]string{
 					"annotationKey": "annotationValue",
 				}),
-				WithPodSet(constants.DatasetInitializer, ptr.To(constants.DatasetInitializer), 1, corev1.PodSpec{
+				WithPodSet(constants.DatasetInitializer, ptr.To(constants.DatasetInitializer), 1, 1, corev1.PodSpec{
 					Containers: []corev1.Container{{
 						Name: constants.DatasetInitializer,
 						Resources: corev1.ResourceRequirements{
@@ -82,7 +86,7 @@ func TestNewInfo(t *testing.T) {
 								})),
 					),
 				),
-				WithPodSet(constants.ModelInitializer, ptr.To(constants.ModelInitializer), 1, corev1.PodSpec{
+				WithPodSet(constants.ModelInitializer, ptr.To(constants.ModelInitializer), 1, 1, corev1.PodSpec{
 					Containers: []corev1.Container{{
 						Name: constants.ModelInitializer,
 						Resources: corev1.ResourceRequirements{
@@ -119,7 +123,7 @@ func TestNewInfo(t *testing.T) {
 								})),
 					),
 				),
-				WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 10, corev1.PodSpec{
+				WithPodSet(constants.Node, ptr.To(constants.AncestorTrainer), 1, 10, corev1.PodSpec{
 					Containers: []corev1.Container{{
 						Name: constants.Node,
 						Resources: corev1.ResourceRequirements{
@@ -274,6 +278,7 @@ func TestNewInfo(t *testing.T) {
 						{
 							Name:     constants.DatasetInitializer,
 							Ancestor: ptr.To(constants.DatasetInitializer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](1),
 							InitContainers: []Container{{
 								Name: "setup-initializer",
@@ -288,6 +293,7 @@ func TestNewInfo(t *testing.T) {
 						{
 							Name:     constants.ModelInitializer,
 							Ancestor: ptr.To(constants.ModelInitializer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](1),
 							InitContainers: []Container{{
 								Name: "setup-initializer",
@@ -302,6 +308,7 @@ func TestNewInfo(t *testing.T) {
 						{
 							Name:     constants.Node,
 							Ancestor: ptr.To(constants.AncestorTrainer),
+							Replicas: ptr.To[int32](1),
 							Count:    ptr.To[int32](10),
 							Containers: []Container{{
 								Name: constants.Node,
@@ -455,6 +462,7 @@ func TestFindContainerByPodSetAncestorContainerName(t *testing.T) {
 					PodSets: []PodSet{
 						{
 							Ancestor: ptr.To("alpha"),
+							Replicas: ptr.To[int32](1),
 							Containers: []Container{
 								{
 									Name: "one",
@@ -466,6 +474,7 @@ func TestFindContainerByPodSetAncestorContainerName(t *testing.T) {
 						},
 						{
 							Ancestor:   ptr.To("beta"),
+							Replicas:   ptr.To[int32](1),
 							Containers: []Container{{Name: "one"}},
 						},
 					},
@@ -482,6 +491,7 @@ func TestFindContainerByPodSetAncestorContainerName(t *testing.T) {
 				TemplateSpec: TemplateSpec{
 					PodSets: []PodSet{{
 						Ancestor:   ptr.To("alpha"),
+						Replicas:   ptr.To[int32](1),
 						Containers: []Container{{Name: "one"}},
 					}},
 				},
@@ -495,6 +505,7 @@ func TestFindContainerByPodSetAncestorContainerName(t *testing.T) {
 				TemplateSpec: TemplateSpec{
 					PodSets: []PodSet{{
 						Ancestor:   ptr.To("alpha"),
+						Replicas:   ptr.To[int32](1),
 						Containers: []Container{{Name: "one"}},
 					}},
 				},
@@ -562,6 +573,39 @@ func TestFindPodSetByName(t *testing.T) {
 	}
 }
 
+func TestPodSetFirstEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		podSet *PodSet
+		want   string
+	}{
+		"Pod network is not identified": {
+			podSet: &PodSet{
+				Name: "alpha",
+			},
+		},
+		"first endpoint is returned": {
+			podSet: &PodSet{
+				Name: "alpha",
+				Endpoints: func(yield func(string) bool) {
+					if !yield("test-job-alpha-0-0.test-job") {
+						return
+					}
+					yield("test-job-alpha-0-1.test-job")
+				},
+			},
+			want: "test-job-alpha-0-0.test-job",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.podSet.FirstEndpoint()
+			if diff := cmp.Diff(tc.want, got); len(diff) != 0 {
+				t.Errorf("Unexpected endpoint (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestFindContainerByName(t *testing.T) {
 	cases := map[string]struct {
 		info       *Info
@@ -574,9 +618,11 @@ func TestFindContainerByName(t *testing.T) {
 					PodSets: []PodSet{
 						{
 							Ancestor: ptr.To("alpha"),
+							Replicas: ptr.To[int32](1),
 						},
 						{
 							Ancestor: ptr.To("beta"),
+							Replicas: ptr.To[int32](1),
 						},
 					},
 				},
@@ -584,6 +630,7 @@ func TestFindContainerByName(t *testing.T) {
 			psAncestor: "alpha",
 			want: &PodSet{
 				Ancestor: ptr.To("alpha"),
+				Replicas: ptr.To[int32](1),
 			},
 		},
 		"PodSet does not exist": {
@@ -607,3 +654,164 @@ func TestFindContainerByName(t *testing.T) {
 		})
 	}
 }
+
+func TestTotalPodsAndRequests(t *testing.T) {
+	cases := map[string]struct {
+		info         *Info
+		wantPods     int32
+		wantRequests corev1.ResourceList
+	}{
+		"no PodSets": {
+			info:         &Info{},
+			wantRequests: corev1.ResourceList{},
+		},
+		"requests are multiplied by the number of Pods in each PodSet": {
+			info: &Info{
+				TemplateSpec: TemplateSpec{
+					PodSets: []PodSet{
+						{
+							Name:  constants.DatasetInitializer,
+							Count: ptr.To[int32](1),
+							SinglePodRequests: corev1.ResourceList{
+								corev1.ResourceCPU: resource.MustParse("1"),
+							},
+						},
+						{
+							Name:  constants.Node,
+							Count: ptr.To[int32](4),
+							SinglePodRequests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("2"),
+								corev1.ResourceMemory: resource.MustParse("4Gi"),
+							},
+						},
+						{
+							Name: constants.Launcher,
+						},
+					},
+				},
+			},
+			wantPods: 6,
+			wantRequests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("9"),
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotPods, gotRequests := tc.info.TotalPodsAndRequests()
+			if gotPods != tc.wantPods {
+				t.Errorf("Unexpected total Pods: want %d, got %d", tc.wantPods, gotPods)
+			}
+			if diff := cmp.Diff(tc.wantRequests, gotRequests, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Unexpected total requests (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateReplicatedJobs(t *testing.T) {
+	cases := map[string]struct {
+		rJobs     []jobsetv1alpha2.ReplicatedJob
+		mlPolicy  *trainer.MLPolicy
+		wantError field.ErrorList
+	}{
+		"valid replicatedJobs": {
+			rJobs: utiltesting.MakeJobSetWrapper("ns", "valid").
+				LauncherReplica().
+				Replicas(1, constants.Launcher, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
+				Obj().Spec.ReplicatedJobs,
+		},
+		"valid replicatedJobs with unknown user-specified ancestor": {
+			rJobs: utiltesting.MakeJobSetWrapper("ns", "valid").
+				ReplicatedJobLabel(constants.LabelTrainJobAncestor, "user-specified", constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
+				Replicas(2, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
+				Obj().Spec.ReplicatedJobs,
+		},
+		"valid multiple replicas": {
+			rJobs: utiltesting.MakeJobSetWrapper("ns", "valid").
+				Replicas(2, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
+				Obj().Spec.ReplicatedJobs,
+			mlPolicy: utiltesting.MakeMLPolicyWrapper().
+				WithNumNodes(4).
+				Obj(),
+		},
+		"invalid launcher replicas": {
+			rJobs: utiltesting.MakeJobSetWrapper("ns", "valid").
+				LauncherReplica().
+				Replicas(2, constants.Launcher, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
+				Obj().Spec.ReplicatedJobs,
+			wantError: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("template").Child("spec").Child("replicatedJobs").Index(2).Child("replicas"),
+					"2", ""),
+			},
+		},
+		"numNodes is not a multiple of the trainer replicas": {
+			rJobs: utiltesting.MakeJobSetWrapper("ns", "valid").
+				Replicas(2, constants.Node).
+				Obj().Spec.ReplicatedJobs,
+			mlPolicy: utiltesting.MakeMLPolicyWrapper().
+				WithNumNodes(3).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("mlPolicy").Child("numNodes"), "3", ""),
+			},
+		},
+		"trainer replicas must be 1 for the JAX mlPolicy": {
+			rJobs: utiltesting.MakeJobSetWrapper("ns", "valid").
+				Replicas(2, constants.Node).
+				Obj().Spec.ReplicatedJobs,
+			mlPolicy: utiltesting.MakeMLPolicyWrapper().
+				WithNumNodes(4).
+				WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+					JAXPolicy().
+					Obj(),
+				).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("template").Child("spec").Child("replicatedJobs").Index(2).Child("replicas"),
+					"2", ""),
+			},
+		},
+		"trainer replicas must be 1 for the XGBoost mlPolicy": {
+			rJobs: utiltesting.MakeJobSetWrapper("ns", "valid").
+				Replicas(2, constants.Node).
+				Obj().Spec.ReplicatedJobs,
+			mlPolicy: utiltesting.MakeMLPolicyWrapper().
+				WithNumNodes(4).
+				WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+					XGBoostPolicy().
+					Obj(),
+				).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("template").Child("spec").Child("replicatedJobs").Index(2).Child("replicas"),
+					"2", ""),
+			},
+		},
+		"chief replicas must be 1 for the TensorFlow mlPolicy": {
+			rJobs: utiltesting.MakeJobSetWrapper("ns", "valid").
+				ReplicatedJobLabel(constants.LabelTrainJobAncestor, constants.AncestorChief, constants.DatasetInitializer).
+				Replicas(2, constants.DatasetInitializer).
+				Obj().Spec.ReplicatedJobs,
+			mlPolicy: utiltesting.MakeMLPolicyWrapper().
+				WithMLPolicySource(*utiltesting.MakeMLPolicySourceWrapper().
+					TensorFlowPolicy().
+					Obj(),
+				).
+				Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("template").Child("spec").Child("replicatedJobs").Index(0).Child("replicas"),
+					"2", ""),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := ValidateReplicatedJobs(tc.rJobs, tc.mlPolicy)
+			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); len(diff) != 0 {
+				t.Errorf("ValidateReplicatedJobs() mismatch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/utils/ptr"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+)
+
+const (
+	// TracerName is the name of the tracer used for the trainer spans.
+	TracerName = "github.com/kubeflow/trainer/v2"
+
+	// ServiceName is the name of the service reported in the span resource.
+	ServiceName = "kubeflow-trainer-controller-manager"
+)
+
+const (
+	TrainJobNamespaceKey = attribute.Key("trainer.trainjob.namespace")
+	TrainJobNameKey      = attribute.Key("trainer.trainjob.name")
+	RuntimeKindKey       = attribute.Key("trainer.runtime.kind")
+	RuntimeNameKey       = attribute.Key("trainer.runtime.name")
+	PluginKey            = attribute.Key("trainer.plugin")
+	ObjectsCountKey      = attribute.Key("trainer.objects.count")
+	PodSetsCountKey      = attribute.Key("trainer.podsets.count")
+)
+
+// Setup configures the global TracerProvider with the exporter from the configuration.
+// The returned function flushes the pending spans and shuts down the TracerProvider.
+func Setup(ctx context.Context, cfg *configapi.Tracing) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+	if cfg == nil {
+		return noop, nil
+	}
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Exporter {
+	case "", configapi.TracingExporterNone:
+		return noop, nil
+	case configapi.TracingExporterStdout:
+		exporter, err = stdouttrace.New()
+	case configapi.TracingExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if ptr.Deref(cfg.Insecure, false) {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unsupported tracing exporter: %q", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the %s tracing exporter: %w", cfg.Exporter, err)
+	}
+	rate := ptr.Deref(cfg.SamplingRatePerMillion, configapi.DefaultSamplingRatePerMillion)
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(float64(rate)/1000000))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(ServiceName))),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// Tracer returns the trainer tracer from the global TracerProvider.
+// The spans are discarded unless the TracerProvider is configured by Setup.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// TrainJobAttributes returns the span attributes identifying the TrainJob and its runtime.
+func TrainJobAttributes(trainJob *trainer.TrainJob) []attribute.KeyValue {
+	if trainJob == nil {
+		return nil
+	}
+	attrs := []attribute.KeyValue{
+		TrainJobNamespaceKey.String(trainJob.Namespace),
+		TrainJobNameKey.String(trainJob.Name),
+		RuntimeNameKey.String(trainJob.Spec.RuntimeRef.Name),
+	}
+	if kind := ptr.Deref(trainJob.Spec.RuntimeRef.Kind, ""); len(kind) != 0 {
+		attrs = append(attrs, RuntimeKindKey.String(kind))
+	}
+	return attrs
+}
+
+// EndSpan records the error, if any, on the span and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"k8s.io/utils/ptr"
+
+	configapi "github.com/kubeflow/trainer/v2/pkg/apis/config/v1alpha1"
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	utiltesting "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+func TestSetup(t *testing.T) {
+	cases := map[string]struct {
+		cfg     *configapi.Tracing
+		wantErr bool
+	}{
+		"tracing is not configured": {},
+		"None exporter": {
+			cfg: &configapi.Tracing{Exporter: configapi.TracingExporterNone},
+		},
+		"Stdout exporter": {
+			cfg: &configapi.Tracing{Exporter: configapi.TracingExporterStdout},
+		},
+		"OTLP exporter": {
+			cfg: &configapi.Tracing{
+				Exporter:               configapi.TracingExporterOTLP,
+				Endpoint:               "localhost:4317",
+				Insecure:               ptr.To(true),
+				SamplingRatePerMillion: ptr.To[int32](500000),
+			},
+		},
+		"unsupported exporter": {
+			cfg:     &configapi.Tracing{Exporter: "Zipkin"},
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			shutdown, err := Setup(ctx, tc.cfg)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tc.wantErr {
+				return
+			}
+			if err = shutdown(ctx); err != nil {
+				t.Errorf("Unexpected error on shutdown: %v", err)
+			}
+		})
+	}
+}
+
+func TestTrainJobAttributes(t *testing.T) {
+	cases := map[string]struct {
+		trainJob  *trainer.TrainJob
+		wantAttrs []attribute.KeyValue
+	}{
+		"TrainJob with the ClusterTrainingRuntime": {
+			trainJob: utiltesting.MakeTrainJobWrapper("default", "test").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind), "torch").
+				Obj(),
+			wantAttrs: []attribute.KeyValue{
+				TrainJobNamespaceKey.String("default"),
+				TrainJobNameKey.String("test"),
+				RuntimeNameKey.String("torch"),
+				RuntimeKindKey.String(trainer.ClusterTrainingRuntimeKind),
+			},
+		},
+		"TrainJob is nil": {},
+		"TrainJob without the runtime kind": {
+			trainJob: &trainer.TrainJob{},
+			wantAttrs: []attribute.KeyValue{
+				TrainJobNamespaceKey.String(""),
+				TrainJobNameKey.String(""),
+				RuntimeNameKey.String(""),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := TrainJobAttributes(tc.trainJob)
+			if diff := cmp.Diff(tc.wantAttrs, got, cmp.AllowUnexported(attribute.Value{})); len(diff) != 0 {
+				t.Errorf("Unexpected attributes (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEndSpan(t *testing.T) {
+	cases := map[string]struct {
+		err        error
+		wantStatus sdktrace.Status
+		wantEvents int
+	}{
+		"span without error": {
+			wantStatus: sdktrace.Status{Code: codes.Unset},
+		},
+		"span with error": {
+			err:        errors.New("test error"),
+			wantStatus: sdktrace.Status{Code: codes.Error, Description: "test error"},
+			wantEvents: 1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+			_, span := tp.Tracer(TracerName).Start(context.Background(), "test")
+			EndSpan(span, tc.err)
+			spans := recorder.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("Unexpected number of ended spans: %d", len(spans))
+			}
+			if diff := cmp.Diff(tc.wantStatus, spans[0].Status()); len(diff) != 0 {
+				t.Errorf("Unexpected span status (-want,+got):\n%s", diff)
+			}
+			if len(spans[0].Events()) != tc.wantEvents {
+				t.Errorf("Unexpected number of span events, want %d, got %d", tc.wantEvents, len(spans[0].Events()))
+			}
+		})
+	}
+}
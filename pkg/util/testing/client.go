@@ -20,13 +20,16 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 )
@@ -37,11 +40,30 @@ func NewClientBuilder(addToSchemes ...func(s *runtime.Scheme) error) *fake.Clien
 	utilruntime.Must(trainer.AddToScheme(scm))
 	utilruntime.Must(jobsetv1alpha2.AddToScheme(scm))
 	utilruntime.Must(schedulerpluginsv1alpha1.AddToScheme(scm))
+	utilruntime.Must(volcanov1beta1.AddToScheme(scm))
 	for i := range addToSchemes {
 		utilruntime.Must(addToSchemes[i](scm))
 	}
 	return fake.NewClientBuilder().
-		WithScheme(scm)
+		WithScheme(scm).
+		WithRESTMapper(newRESTMapper(scm))
+}
+
+// newRESTMapper returns the RESTMapper which knows the external CRDs,
+// so that plugins can verify that their required CRDs are installed.
+func newRESTMapper(scm *runtime.Scheme) meta.RESTMapper {
+	gvs := []schema.GroupVersion{
+		jobsetv1alpha2.SchemeGroupVersion,
+		schedulerpluginsv1alpha1.SchemeGroupVersion,
+		volcanov1beta1.SchemeGroupVersion,
+	}
+	mapper := meta.NewDefaultRESTMapper(gvs)
+	for _, gv := range gvs {
+		for kind := range scm.KnownTypes(gv) {
+			mapper.Add(gv.WithKind(kind), meta.RESTScopeNamespace)
+		}
+	}
+	return mapper
 }
 
 type builderIndexer struct {
@@ -21,6 +21,7 @@ import (
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -28,6 +29,7 @@ import (
 	"k8s.io/utils/ptr"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	schedulerpluginsv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
@@ -428,6 +430,16 @@ func (j *JobSetWrapper) PodLabel(key, value string) *JobSetWrapper {
 	return j
 }
 
+func (j *JobSetWrapper) PodAnnotation(key, value string) *JobSetWrapper {
+	for i, rJob := range j.Spec.ReplicatedJobs {
+		if rJob.Template.Spec.Template.Annotations == nil {
+			j.Spec.ReplicatedJobs[i].Template.Spec.Template.Annotations = make(map[string]string, 1)
+		}
+		j.Spec.ReplicatedJobs[i].Template.Spec.Template.Annotations[key] = value
+	}
+	return j
+}
+
 func (j *JobSetWrapper) Label(key, value string) *JobSetWrapper {
 	if j.ObjectMeta.Labels == nil {
 		j.ObjectMeta.Labels = make(map[string]string, 1)
@@ -451,6 +463,23 @@ func (j *JobSetWrapper) Conditions(conditions ...metav1.Condition) *JobSetWrappe
 	return j
 }
 
+func (j *JobSetWrapper) ReplicatedJobsStatus(rJobsStatus ...jobsetv1alpha2.ReplicatedJobStatus) *JobSetWrapper {
+	if len(rJobsStatus) != 0 {
+		j.Status.ReplicatedJobsStatus = append(j.Status.ReplicatedJobsStatus, rJobsStatus...)
+	}
+	return j
+}
+
+func (j *JobSetWrapper) FailurePolicy(failurePolicy *jobsetv1alpha2.FailurePolicy) *JobSetWrapper {
+	j.Spec.FailurePolicy = failurePolicy
+	return j
+}
+
+func (j *JobSetWrapper) Restarts(restarts int32) *JobSetWrapper {
+	j.Status.Restarts = restarts
+	return j
+}
+
 func (j *JobSetWrapper) DependsOn(rJobName string, dependsOn ...jobsetv1alpha2.DependsOn) *JobSetWrapper {
 	for i, rJob := range j.Spec.ReplicatedJobs {
 		if rJob.Name == rJobName {
@@ -489,11 +518,32 @@ func (t *TrainJobWrapper) Suspend(suspend bool) *TrainJobWrapper {
 	return t
 }
 
+func (t *TrainJobWrapper) CreationTimestamp(timestamp metav1.Time) *TrainJobWrapper {
+	t.ObjectMeta.CreationTimestamp = timestamp
+	return t
+}
+
 func (t *TrainJobWrapper) UID(uid string) *TrainJobWrapper {
 	t.ObjectMeta.UID = types.UID(uid)
 	return t
 }
 
+func (t *TrainJobWrapper) Label(key, value string) *TrainJobWrapper {
+	if t.Labels == nil {
+		t.Labels = make(map[string]string, 1)
+	}
+	t.Labels[key] = value
+	return t
+}
+
+func (t *TrainJobWrapper) Annotation(key, value string) *TrainJobWrapper {
+	if t.Annotations == nil {
+		t.Annotations = make(map[string]string, 1)
+	}
+	t.Annotations[key] = value
+	return t
+}
+
 func (t *TrainJobWrapper) SpecLabel(key, value string) *TrainJobWrapper {
 	if t.Spec.Labels == nil {
 		t.Spec.Labels = make(map[string]string, 1)
@@ -544,6 +594,59 @@ func (t *TrainJobWrapper) ManagedBy(m string) *TrainJobWrapper {
 	return t
 }
 
+func (t *TrainJobWrapper) FailurePolicy(failurePolicy *trainer.FailurePolicy) *TrainJobWrapper {
+	t.Spec.FailurePolicy = failurePolicy
+	return t
+}
+
+func (t *TrainJobWrapper) ActiveDeadlineSeconds(seconds int64) *TrainJobWrapper {
+	t.Spec.ActiveDeadlineSeconds = &seconds
+	return t
+}
+
+func (t *TrainJobWrapper) TTLSecondsAfterFinished(ttl int32) *TrainJobWrapper {
+	t.Spec.TTLSecondsAfterFinished = &ttl
+	return t
+}
+
+func (t *TrainJobWrapper) Condition(condition metav1.Condition) *TrainJobWrapper {
+	meta.SetStatusCondition(&t.Status.Conditions, condition)
+	return t
+}
+
+func (t *TrainJobWrapper) CompletionTime(completionTime metav1.Time) *TrainJobWrapper {
+	t.Status.CompletionTime = &completionTime
+	return t
+}
+
+func (t *TrainJobWrapper) StartTime(startTime metav1.Time) *TrainJobWrapper {
+	t.Status.StartTime = &startTime
+	return t
+}
+
+func (t *TrainJobWrapper) ActiveSeconds(activeSeconds int64) *TrainJobWrapper {
+	t.Status.ActiveSeconds = &activeSeconds
+	return t
+}
+
+func (t *TrainJobWrapper) RuntimeSnapshot(name string, runtimeGeneration int64) *TrainJobWrapper {
+	t.Status.RuntimeSnapshot = &trainer.RuntimeSnapshot{
+		Name:              name,
+		RuntimeGeneration: runtimeGeneration,
+	}
+	return t
+}
+
+func (t *TrainJobWrapper) ResourcesCreationRetries(retries int32) *TrainJobWrapper {
+	t.Status.ResourcesCreationRetries = &retries
+	return t
+}
+
+func (t *TrainJobWrapper) LastResourcesCreationAttemptTime(attemptTime metav1.Time) *TrainJobWrapper {
+	t.Status.LastResourcesCreationAttemptTime = &attemptTime
+	return t
+}
+
 func (t *TrainJobWrapper) Obj() *trainer.TrainJob {
 	return &t.TrainJob
 }
@@ -832,6 +935,11 @@ func (r *TrainingRuntimeWrapper) RuntimeSpec(spec trainer.TrainingRuntimeSpec) *
 	return r
 }
 
+func (r *TrainingRuntimeWrapper) RuntimeStatus(status trainer.TrainingRuntimeStatus) *TrainingRuntimeWrapper {
+	r.Status = status
+	return r
+}
+
 func (r *TrainingRuntimeWrapper) Obj() *trainer.TrainingRuntime {
 	return &r.TrainingRuntime
 }
@@ -967,6 +1075,11 @@ func (r *ClusterTrainingRuntimeWrapper) RuntimeSpec(spec trainer.TrainingRuntime
 	return r
 }
 
+func (r *ClusterTrainingRuntimeWrapper) RuntimeStatus(status trainer.TrainingRuntimeStatus) *ClusterTrainingRuntimeWrapper {
+	r.Status = status
+	return r
+}
+
 func (r *ClusterTrainingRuntimeWrapper) Obj() *trainer.ClusterTrainingRuntime {
 	return &r.ClusterTrainingRuntime
 }
@@ -1104,6 +1217,23 @@ func (s *TrainingRuntimeSpecWrapper) PodGroupPolicyCoschedulingSchedulingTimeout
 	return s
 }
 
+func (s *TrainingRuntimeSpecWrapper) PodGroupPolicyVolcano(src *trainer.VolcanoPodGroupPolicySource) *TrainingRuntimeSpecWrapper {
+	s.PodGroupPolicy = &trainer.PodGroupPolicy{
+		PodGroupPolicySource: trainer.PodGroupPolicySource{
+			Volcano: src,
+		},
+	}
+	return s
+}
+
+func (s *TrainingRuntimeSpecWrapper) Deprecation(replacedBy *trainer.RuntimeRef, rejectAfter *metav1.Time) *TrainingRuntimeSpecWrapper {
+	s.TrainingRuntimeSpec.Deprecation = &trainer.RuntimeDeprecation{
+		ReplacedBy:  replacedBy,
+		RejectAfter: rejectAfter,
+	}
+	return s
+}
+
 func (s *TrainingRuntimeSpecWrapper) Obj() trainer.TrainingRuntimeSpec {
 	return s.TrainingRuntimeSpec
 }
@@ -1162,6 +1292,31 @@ func (m *MLPolicySourceWrapper) MPIPolicy(numProcPerNode *int32, MPImplementatio
 	return m
 }
 
+func (m *MLPolicySourceWrapper) JAXPolicy() *MLPolicySourceWrapper {
+	m.JAX = &trainer.JAXMLPolicySource{}
+	return m
+}
+
+func (m *MLPolicySourceWrapper) TensorFlowPolicy() *MLPolicySourceWrapper {
+	m.TensorFlow = &trainer.TensorFlowMLPolicySource{}
+	return m
+}
+
+func (m *MLPolicySourceWrapper) XGBoostPolicy() *MLPolicySourceWrapper {
+	m.XGBoost = &trainer.XGBoostMLPolicySource{}
+	return m
+}
+
+func (m *MLPolicySourceWrapper) DeepSpeedPolicy(numProcPerNode *int32, sshAuthMountPath *string, runLauncherAsNode *bool) *MLPolicySourceWrapper {
+	if m.DeepSpeed == nil {
+		m.DeepSpeed = &trainer.DeepSpeedMLPolicySource{}
+	}
+	m.DeepSpeed.NumProcPerNode = numProcPerNode
+	m.DeepSpeed.SSHAuthMountPath = sshAuthMountPath
+	m.DeepSpeed.RunLauncherAsNode = runLauncherAsNode
+	return m
+}
+
 func (m *MLPolicySourceWrapper) Obj() *trainer.MLPolicySource {
 	return &m.MLPolicySource
 }
@@ -1216,6 +1371,61 @@ func (p *SchedulerPluginsPodGroupWrapper) Obj() *schedulerpluginsv1alpha1.PodGro
 	return &p.PodGroup
 }
 
+type VolcanoPodGroupWrapper struct {
+	volcanov1beta1.PodGroup
+}
+
+func MakeVolcanoPodGroup(namespace, name string) *VolcanoPodGroupWrapper {
+	return &VolcanoPodGroupWrapper{
+		PodGroup: volcanov1beta1.PodGroup{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: volcanov1beta1.SchemeGroupVersion.String(),
+				Kind:       constants.PodGroupKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+		},
+	}
+}
+
+func (p *VolcanoPodGroupWrapper) MinMember(members int32) *VolcanoPodGroupWrapper {
+	p.PodGroup.Spec.MinMember = members
+	return p
+}
+
+func (p *VolcanoPodGroupWrapper) MinResources(resources corev1.ResourceList) *VolcanoPodGroupWrapper {
+	p.PodGroup.Spec.MinResources = &resources
+	return p
+}
+
+func (p *VolcanoPodGroupWrapper) Queue(queue string) *VolcanoPodGroupWrapper {
+	p.PodGroup.Spec.Queue = queue
+	return p
+}
+
+func (p *VolcanoPodGroupWrapper) PriorityClassName(priorityClassName string) *VolcanoPodGroupWrapper {
+	p.PodGroup.Spec.PriorityClassName = priorityClassName
+	return p
+}
+
+func (p *VolcanoPodGroupWrapper) ControllerReference(gvk schema.GroupVersionKind, name, uid string) *VolcanoPodGroupWrapper {
+	p.OwnerReferences = append(p.OwnerReferences, metav1.OwnerReference{
+		APIVersion:         gvk.GroupVersion().String(),
+		Kind:               gvk.Kind,
+		Name:               name,
+		UID:                types.UID(uid),
+		Controller:         ptr.To(true),
+		BlockOwnerDeletion: ptr.To(true),
+	})
+	return p
+}
+
+func (p *VolcanoPodGroupWrapper) Obj() *volcanov1beta1.PodGroup {
+	return &p.PodGroup
+}
+
 type ConfigMapWrapper struct {
 	corev1.ConfigMap
 }
@@ -17,6 +17,8 @@ limitations under the License.
 package trainjob
 
 import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
@@ -31,3 +33,21 @@ func RuntimeRefIsClusterTrainingRuntime(ref trainer.RuntimeRef) bool {
 	return ptr.Equal(ref.APIGroup, &trainer.GroupVersion.Group) &&
 		ptr.Equal(ref.Kind, ptr.To(trainer.ClusterTrainingRuntimeKind))
 }
+
+// IsManagedByTrainJobController checks whether the TrainJob should be reconciled by the built-in TrainJob controller.
+// The TrainJob without the managedBy field is reconciled by the built-in TrainJob controller.
+func IsManagedByTrainJobController(trainJob *trainer.TrainJob) bool {
+	return ptr.Deref(trainJob.Spec.ManagedBy, trainer.TrainJobControllerName) == trainer.TrainJobControllerName
+}
+
+// IsFinished checks whether the TrainJob has finished with either Complete or Failed condition.
+func IsFinished(trainJob *trainer.TrainJob) bool {
+	return meta.IsStatusConditionTrue(trainJob.Status.Conditions, trainer.TrainJobComplete) ||
+		meta.IsStatusConditionTrue(trainJob.Status.Conditions, trainer.TrainJobFailed)
+}
+
+// IsDeadlineExceeded checks whether the TrainJob has failed since it exceeded the activeDeadlineSeconds.
+func IsDeadlineExceeded(trainJob *trainer.TrainJob) bool {
+	failed := meta.FindStatusCondition(trainJob.Status.Conditions, trainer.TrainJobFailed)
+	return failed != nil && failed.Status == metav1.ConditionTrue && failed.Reason == trainer.TrainJobDeadlineExceededReason
+}
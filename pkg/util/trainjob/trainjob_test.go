@@ -19,6 +19,7 @@ package trainjob
 import (
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
@@ -83,3 +84,132 @@ func TestRuntimeRefIsClusterTrainingRuntime(t *testing.T) {
 		})
 	}
 }
+
+func TestIsManagedByTrainJobController(t *testing.T) {
+	cases := map[string]struct {
+		trainJob *trainer.TrainJob
+		want     bool
+	}{
+		"managedBy is not set": {
+			trainJob: &trainer.TrainJob{},
+			want:     true,
+		},
+		"managedBy is the TrainJob controller": {
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					ManagedBy: ptr.To(trainer.TrainJobControllerName),
+				},
+			},
+			want: true,
+		},
+		"managedBy is the MultiKueue": {
+			trainJob: &trainer.TrainJob{
+				Spec: trainer.TrainJobSpec{
+					ManagedBy: ptr.To(trainer.MultiKueueControllerName),
+				},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsManagedByTrainJobController(tc.trainJob)
+			if got != tc.want {
+				t.Errorf("Unexpected IsManagedByTrainJobController()\nwant: %v\n, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsDeadlineExceeded(t *testing.T) {
+	cases := map[string]struct {
+		trainJob *trainer.TrainJob
+		want     bool
+	}{
+		"TrainJob is not failed": {
+			trainJob: &trainer.TrainJob{},
+			want:     false,
+		},
+		"TrainJob is failed with another reason": {
+			trainJob: &trainer.TrainJob{
+				Status: trainer.TrainJobStatus{
+					Conditions: []metav1.Condition{{
+						Type:   trainer.TrainJobFailed,
+						Status: metav1.ConditionTrue,
+						Reason: trainer.TrainJobRuntimeNotSupportedReason,
+					}},
+				},
+			},
+			want: false,
+		},
+		"TrainJob is failed with the DeadlineExceeded reason": {
+			trainJob: &trainer.TrainJob{
+				Status: trainer.TrainJobStatus{
+					Conditions: []metav1.Condition{{
+						Type:   trainer.TrainJobFailed,
+						Status: metav1.ConditionTrue,
+						Reason: trainer.TrainJobDeadlineExceededReason,
+					}},
+				},
+			},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDeadlineExceeded(tc.trainJob)
+			if got != tc.want {
+				t.Errorf("Unexpected IsDeadlineExceeded()\nwant: %v\n, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsFinished(t *testing.T) {
+	cases := map[string]struct {
+		trainJob *trainer.TrainJob
+		want     bool
+	}{
+		"TrainJob is running": {
+			trainJob: &trainer.TrainJob{
+				Status: trainer.TrainJobStatus{
+					Conditions: []metav1.Condition{{
+						Type:   trainer.TrainJobCreated,
+						Status: metav1.ConditionTrue,
+					}},
+				},
+			},
+			want: false,
+		},
+		"TrainJob is complete": {
+			trainJob: &trainer.TrainJob{
+				Status: trainer.TrainJobStatus{
+					Conditions: []metav1.Condition{{
+						Type:   trainer.TrainJobComplete,
+						Status: metav1.ConditionTrue,
+					}},
+				},
+			},
+			want: true,
+		},
+		"TrainJob is failed": {
+			trainJob: &trainer.TrainJob{
+				Status: trainer.TrainJobStatus{
+					Conditions: []metav1.Condition{{
+						Type:   trainer.TrainJobFailed,
+						Status: metav1.ConditionTrue,
+					}},
+				},
+			},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsFinished(tc.trainJob)
+			if got != tc.want {
+				t.Errorf("Unexpected IsFinished()\nwant: %v\n, got: %v", tc.want, got)
+			}
+		})
+	}
+}
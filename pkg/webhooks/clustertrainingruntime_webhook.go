@@ -19,24 +19,28 @@ package webhooks
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	idxer "github.com/kubeflow/trainer/v2/pkg/runtime/indexer"
 )
 
 type ClusterTrainingRuntimeWebhook struct {
+	client   client.Client
 	runtimes map[string]runtime.Runtime
 }
 
 func setupWebhookForClusterTrainingRuntime(mgr ctrl.Manager, run map[string]runtime.Runtime) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&trainer.ClusterTrainingRuntime{}).
-		WithValidator(&ClusterTrainingRuntimeWebhook{runtimes: run}).
+		WithValidator(&ClusterTrainingRuntimeWebhook{client: mgr.GetClient(), runtimes: run}).
 		Complete()
 }
 
@@ -48,14 +52,30 @@ func (w *ClusterTrainingRuntimeWebhook) ValidateCreate(ctx context.Context, obj
 	clTrainingRuntime := obj.(*trainer.ClusterTrainingRuntime)
 	log := ctrl.LoggerFrom(ctx).WithName("clustertrainingruntime-webhook")
 	log.V(5).Info("Validating create", "clusterTrainingRuntime", klog.KObj(clTrainingRuntime))
-	return nil, validateReplicatedJobs(clTrainingRuntime.Spec.Template.Spec.ReplicatedJobs).ToAggregate()
+	warnings, errs := validateRuntimeSpec(ctx, w.runtimes, trainer.ClusterTrainingRuntimeKind, &clTrainingRuntime.Spec)
+	return warnings, errs.ToAggregate()
 }
 
 func (w *ClusterTrainingRuntimeWebhook) ValidateUpdate(ctx context.Context, oldObj apiruntime.Object, newObj apiruntime.Object) (admission.Warnings, error) {
+	clTrainingRuntimeOld := oldObj.(*trainer.ClusterTrainingRuntime)
 	clTrainingRuntimeNew := newObj.(*trainer.ClusterTrainingRuntime)
 	log := ctrl.LoggerFrom(ctx).WithName("clustertrainingruntime-webhook")
 	log.V(5).Info("Validating update", "clusterTrainingRuntime", klog.KObj(clTrainingRuntimeNew))
-	return nil, validateReplicatedJobs(clTrainingRuntimeNew.Spec.Template.Spec.ReplicatedJobs).ToAggregate()
+	// The updates without spec changes, e.g. adding finalizers, are allowed even for the runtimes
+	// which were created before the validation rules were introduced, so that they can be deleted.
+	if equality.Semantic.DeepEqual(clTrainingRuntimeOld.Spec, clTrainingRuntimeNew.Spec) {
+		return nil, nil
+	}
+	warnings, allErrs := validateRuntimeSpec(ctx, w.runtimes, trainer.ClusterTrainingRuntimeKind, &clTrainingRuntimeNew.Spec)
+
+	var trainJobs trainer.TrainJobList
+	if err := w.client.List(ctx, &trainJobs, client.MatchingFields{
+		idxer.TrainJobClusterRuntimeRefKey: clTrainingRuntimeNew.Name,
+	}); err != nil {
+		return nil, err
+	}
+	updateWarnings, errs := validateRuntimeUpdate(&clTrainingRuntimeOld.Spec, &clTrainingRuntimeNew.Spec, trainJobs.Items)
+	return append(warnings, updateWarnings...), append(allErrs, errs...).ToAggregate()
 }
 
 func (w *ClusterTrainingRuntimeWebhook) ValidateDelete(context.Context, apiruntime.Object) (admission.Warnings, error) {
@@ -18,12 +18,17 @@ package webhooks
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
@@ -31,20 +36,24 @@ import (
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	idxer "github.com/kubeflow/trainer/v2/pkg/runtime/indexer"
+	"github.com/kubeflow/trainer/v2/pkg/util/trainjob"
 )
 
 const (
-	rJobReplicasErrorMsg = "always must be 1"
+	// maxReportedTrainJobs is the maximum number of TrainJob names reported in the runtime update validation messages.
+	maxReportedTrainJobs = 3
 )
 
 type TrainingRuntimeWebhook struct {
+	client   client.Client
 	runtimes map[string]runtime.Runtime
 }
 
 func setupWebhookForTrainingRuntime(mgr ctrl.Manager, run map[string]runtime.Runtime) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&trainer.TrainingRuntime{}).
-		WithValidator(&TrainingRuntimeWebhook{runtimes: run}).
+		WithValidator(&TrainingRuntimeWebhook{client: mgr.GetClient(), runtimes: run}).
 		Complete()
 }
 
@@ -56,35 +65,144 @@ func (w *TrainingRuntimeWebhook) ValidateCreate(ctx context.Context, obj apirunt
 	trainingRuntime := obj.(*trainer.TrainingRuntime)
 	log := ctrl.LoggerFrom(ctx).WithName("trainingruntime-webhook")
 	log.V(5).Info("Validating create", "trainingRuntime", klog.KObj(trainingRuntime))
-	return nil, validateReplicatedJobs(trainingRuntime.Spec.Template.Spec.ReplicatedJobs).ToAggregate()
+	warnings, errs := validateRuntimeSpec(ctx, w.runtimes, trainer.TrainingRuntimeKind, &trainingRuntime.Spec)
+	return warnings, errs.ToAggregate()
 }
 
-func validateReplicatedJobs(rJobs []jobsetv1alpha2.ReplicatedJob) field.ErrorList {
-	ancestors := sets.New(constants.AncestorTrainer, constants.ModelInitializer, constants.DatasetInitializer)
+// validateRuntimeSpec validates the runtime spec by the runtime of the runtime kind.
+func validateRuntimeSpec(ctx context.Context, runtimes map[string]runtime.Runtime, kind string, spec *trainer.TrainingRuntimeSpec) (admission.Warnings, field.ErrorList) {
+	runtimeRef := trainer.RuntimeRef{
+		APIGroup: ptr.To(trainer.GroupVersion.Group),
+		Kind:     ptr.To(kind),
+	}
+	return runtime.ValidateRuntimeSpec(ctx, runtimes[runtime.RuntimeRefToRuntimeRegistryKey(runtimeRef)], spec)
+}
+
+func (w *TrainingRuntimeWebhook) ValidateUpdate(ctx context.Context, oldObj apiruntime.Object, newObj apiruntime.Object) (admission.Warnings, error) {
+	trainingRuntimeOld := oldObj.(*trainer.TrainingRuntime)
+	trainingRuntimeNew := newObj.(*trainer.TrainingRuntime)
+	log := ctrl.LoggerFrom(ctx).WithName("trainingruntime-webhook")
+	log.V(5).Info("Validating update", "trainingRuntime", klog.KObj(trainingRuntimeNew))
+	// The updates without spec changes, e.g. adding finalizers, are allowed even for the runtimes
+	// which were created before the validation rules were introduced, so that they can be deleted.
+	if equality.Semantic.DeepEqual(trainingRuntimeOld.Spec, trainingRuntimeNew.Spec) {
+		return nil, nil
+	}
+	warnings, allErrs := validateRuntimeSpec(ctx, w.runtimes, trainer.TrainingRuntimeKind, &trainingRuntimeNew.Spec)
+
+	var trainJobs trainer.TrainJobList
+	if err := w.client.List(ctx, &trainJobs, client.InNamespace(trainingRuntimeNew.Namespace), client.MatchingFields{
+		idxer.TrainJobRuntimeRefKey: trainingRuntimeNew.Name,
+	}); err != nil {
+		return nil, err
+	}
+	updateWarnings, errs := validateRuntimeUpdate(&trainingRuntimeOld.Spec, &trainingRuntimeNew.Spec, trainJobs.Items)
+	return append(warnings, updateWarnings...), append(allErrs, errs...).ToAggregate()
+}
+
+// validateRuntimeUpdate validates the runtime spec update against the active TrainJobs referencing the runtime.
+// The breaking changes are rejected when any active TrainJob isn't pinned to the runtime snapshot yet,
+// since the TrainJob would be built from the updated runtime spec. Otherwise, the breaking changes are warned
+// since the TrainJobs would be broken once they are re-resolved to the latest runtime.
+func validateRuntimeUpdate(oldSpec, newSpec *trainer.TrainingRuntimeSpec, trainJobs []trainer.TrainJob) (admission.Warnings, field.ErrorList) {
+	breakingErrs := validateRuntimeSpecCompatibility(oldSpec, newSpec)
+	if len(breakingErrs) == 0 {
+		return nil, nil
+	}
+	var unpinned, pinned []string
+	for _, tj := range trainJobs {
+		if trainjob.IsFinished(&tj) {
+			continue
+		}
+		if tj.Status.RuntimeSnapshot == nil {
+			unpinned = append(unpinned, klog.KObj(&tj).String())
+		} else {
+			pinned = append(pinned, klog.KObj(&tj).String())
+		}
+	}
+	if len(unpinned) != 0 {
+		for _, err := range breakingErrs {
+			err.Detail = fmt.Sprintf("%s while the runtime is used by the active TrainJobs: %s", err.Detail, reportedTrainJobs(unpinned))
+		}
+		return nil, breakingErrs
+	}
+	if len(pinned) == 0 {
+		return nil, nil
+	}
+	warnings := make(admission.Warnings, 0, len(breakingErrs))
+	for _, err := range breakingErrs {
+		warnings = append(warnings, fmt.Sprintf("%s: the TrainJobs pinned to the runtime snapshot are broken once they are re-resolved: %s",
+			err.Error(), reportedTrainJobs(pinned)))
+	}
+	return warnings, nil
+}
+
+// validateRuntimeSpecCompatibility detects the runtime spec changes which break the TrainJobs built from the old spec.
+func validateRuntimeSpecCompatibility(oldSpec, newSpec *trainer.TrainingRuntimeSpec) field.ErrorList {
 	rJobsPath := field.NewPath("spec").
 		Child("template").
 		Child("spec").
 		Child("replicatedJobs")
 	var allErrs field.ErrorList
-	for idx, rJob := range rJobs {
-		if rJob.Name == constants.Launcher && rJob.Replicas != 1 {
-			allErrs = append(allErrs, field.Invalid(rJobsPath.Index(idx).Child("replicas"), rJob.Replicas, rJobReplicasErrorMsg))
+	newAncestors := sets.New[string]()
+	newHasNodeContainer := false
+	for _, rJob := range newSpec.Template.Spec.ReplicatedJobs {
+		if ancestor, ok := rJob.Template.Labels[constants.LabelTrainJobAncestor]; ok {
+			newAncestors.Insert(ancestor)
 		}
-
-		if rJob.Template.Labels == nil {
-			continue
+		newHasNodeContainer = newHasNodeContainer || hasContainer(rJob, constants.Node)
+	}
+	oldHasNodeContainer := false
+	for _, rJob := range oldSpec.Template.Spec.ReplicatedJobs {
+		if ancestor, ok := rJob.Template.Labels[constants.LabelTrainJobAncestor]; ok && !newAncestors.Has(ancestor) {
+			allErrs = append(allErrs, field.Forbidden(rJobsPath, fmt.Sprintf("must not remove the %s replicatedJob with the %s ancestor", rJob.Name, ancestor)))
 		}
+		oldHasNodeContainer = oldHasNodeContainer || hasContainer(rJob, constants.Node)
+	}
+	if oldHasNodeContainer && !newHasNodeContainer {
+		allErrs = append(allErrs, field.Forbidden(rJobsPath, fmt.Sprintf("must not remove the %s container", constants.Node)))
+	}
+	if oldKind, newKind := mlPolicyKind(oldSpec.MLPolicy), mlPolicyKind(newSpec.MLPolicy); oldKind != newKind {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("mlPolicy"), fmt.Sprintf("must not change the mlPolicy kind from %q to %q", oldKind, newKind)))
+	}
+	return allErrs
+}
 
-		if labelAncestor, ok := rJob.Template.Labels[constants.LabelTrainJobAncestor]; ok && ancestors.Has(labelAncestor) && rJob.Replicas != 1 {
-			allErrs = append(allErrs, field.Invalid(rJobsPath.Index(idx).Child("replicas"), rJob.Replicas, rJobReplicasErrorMsg))
+func hasContainer(rJob jobsetv1alpha2.ReplicatedJob, name string) bool {
+	for _, c := range rJob.Template.Spec.Template.Spec.Containers {
+		if c.Name == name {
+			return true
 		}
+	}
+	return false
+}
 
+// mlPolicyKind returns the name of the MLPolicy source, or an empty string when the MLPolicy source isn't set.
+func mlPolicyKind(mlPolicy *trainer.MLPolicy) string {
+	switch {
+	case mlPolicy == nil:
+		return ""
+	case mlPolicy.Torch != nil:
+		return "torch"
+	case mlPolicy.MPI != nil:
+		return "mpi"
+	case mlPolicy.JAX != nil:
+		return "jax"
+	case mlPolicy.TensorFlow != nil:
+		return "tensorFlow"
+	case mlPolicy.XGBoost != nil:
+		return "xgBoost"
+	case mlPolicy.DeepSpeed != nil:
+		return "deepSpeed"
 	}
-	return allErrs
+	return ""
 }
 
-func (w *TrainingRuntimeWebhook) ValidateUpdate(context.Context, apiruntime.Object, apiruntime.Object) (admission.Warnings, error) {
-	return nil, nil
+func reportedTrainJobs(trainJobs []string) string {
+	if len(trainJobs) > maxReportedTrainJobs {
+		return fmt.Sprintf("%s and %d more", strings.Join(trainJobs[:maxReportedTrainJobs], ", "), len(trainJobs)-maxReportedTrainJobs)
+	}
+	return strings.Join(trainJobs, ", ")
 }
 
 func (w *TrainingRuntimeWebhook) ValidateDelete(context.Context, apiruntime.Object) (admission.Warnings, error) {
@@ -17,56 +17,194 @@ limitations under the License.
 package webhooks
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
-	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
 	"github.com/kubeflow/trainer/v2/pkg/constants"
+	idxer "github.com/kubeflow/trainer/v2/pkg/runtime/indexer"
 	testingutil "github.com/kubeflow/trainer/v2/pkg/util/testing"
 )
 
-func TestValidateReplicatedJobs(t *testing.T) {
+func TestValidateRuntimeUpdate(t *testing.T) {
+	rJobsPath := field.NewPath("spec").Child("template").Child("spec").Child("replicatedJobs")
+	oldSpec := testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").Obj().Spec
+	oldSpec.MLPolicy = testingutil.MakeMLPolicyWrapper().WithNumNodes(1).
+		WithMLPolicySource(*testingutil.MakeMLPolicySourceWrapper().TorchPolicy(nil, nil).Obj()).
+		Obj()
+	withoutTrainerJob := oldSpec.DeepCopy()
+	withoutTrainerJob.Template.Spec.ReplicatedJobs = withoutTrainerJob.Template.Spec.ReplicatedJobs[:2]
+	withoutTrainerJobErrs := field.ErrorList{
+		field.Forbidden(rJobsPath, fmt.Sprintf("must not remove the %s replicatedJob with the %s ancestor", constants.Node, constants.AncestorTrainer)),
+		field.Forbidden(rJobsPath, fmt.Sprintf("must not remove the %s container", constants.Node)),
+	}
+	activeTrainJob := testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "active").Obj()
+	pinnedTrainJob := testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "pinned").
+		RuntimeSnapshot("pinned-snapshot", 1).
+		Obj()
+	finishedTrainJob := testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "finished").
+		Condition(metav1.Condition{
+			Type:   trainer.TrainJobComplete,
+			Status: metav1.ConditionTrue,
+		}).
+		Obj()
+
 	cases := map[string]struct {
-		rJobs     []jobsetv1alpha2.ReplicatedJob
-		wantError field.ErrorList
+		newSpec      *trainer.TrainingRuntimeSpec
+		trainJobs    []trainer.TrainJob
+		wantWarnings admission.Warnings
+		wantError    field.ErrorList
 	}{
-		"valid replicatedJobs": {
-			rJobs: testingutil.MakeJobSetWrapper("ns", "valid").
-				LauncherReplica().
-				Replicas(1, constants.Launcher, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
-				Obj().Spec.ReplicatedJobs,
+		"compatible changes are allowed for the active TrainJobs": {
+			newSpec: func() *trainer.TrainingRuntimeSpec {
+				spec := oldSpec.DeepCopy()
+				spec.MLPolicy.NumNodes = ptr.To[int32](2)
+				spec.Template.Spec.ReplicatedJobs[2].Template.Spec.Template.Spec.Containers[0].Image = "updated"
+				return spec
+			}(),
+			trainJobs: []trainer.TrainJob{*activeTrainJob},
+		},
+		"breaking changes are allowed without TrainJobs": {
+			newSpec: withoutTrainerJob,
+		},
+		"breaking changes are allowed for the finished TrainJobs": {
+			newSpec:   withoutTrainerJob,
+			trainJobs: []trainer.TrainJob{*finishedTrainJob},
 		},
-		"valid replicatedJobs with unknown user-specified ancestor": {
-			rJobs: testingutil.MakeJobSetWrapper("ns", "valid").
-				ReplicatedJobLabel(constants.LabelTrainJobAncestor, "user-specified", constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
-				Replicas(2, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
-				Obj().Spec.ReplicatedJobs,
+		"removing the trainer job and node container is rejected for the active TrainJobs": {
+			newSpec:   withoutTrainerJob,
+			trainJobs: []trainer.TrainJob{*activeTrainJob, *pinnedTrainJob, *finishedTrainJob},
+			wantError: withoutTrainerJobErrs,
+		},
+		"removing the trainer job and node container is warned for the pinned TrainJobs": {
+			newSpec:   withoutTrainerJob,
+			trainJobs: []trainer.TrainJob{*pinnedTrainJob, *finishedTrainJob},
+			wantWarnings: admission.Warnings{
+				fmt.Sprintf("%s: the TrainJobs pinned to the runtime snapshot are broken once they are re-resolved: default/pinned", withoutTrainerJobErrs[0].Error()),
+				fmt.Sprintf("%s: the TrainJobs pinned to the runtime snapshot are broken once they are re-resolved: default/pinned", withoutTrainerJobErrs[1].Error()),
+			},
+		},
+		"removing the node container is rejected for the active TrainJobs": {
+			newSpec: func() *trainer.TrainingRuntimeSpec {
+				spec := oldSpec.DeepCopy()
+				spec.Template.Spec.ReplicatedJobs[2].Template.Spec.Template.Spec.Containers[0].Name = "trainer"
+				return spec
+			}(),
+			trainJobs: []trainer.TrainJob{*activeTrainJob},
+			wantError: field.ErrorList{
+				field.Forbidden(rJobsPath, fmt.Sprintf("must not remove the %s container", constants.Node)),
+			},
 		},
-		"invalid replicas": {
-			rJobs: testingutil.MakeJobSetWrapper("ns", "valid").
-				LauncherReplica().
-				Replicas(2, constants.Launcher, constants.Node, constants.DatasetInitializer, constants.ModelInitializer).
-				Obj().Spec.ReplicatedJobs,
+		"changing the mlPolicy kind is rejected for the active TrainJobs": {
+			newSpec: func() *trainer.TrainingRuntimeSpec {
+				spec := oldSpec.DeepCopy()
+				spec.MLPolicy = testingutil.MakeMLPolicyWrapper().WithNumNodes(1).
+					WithMLPolicySource(*testingutil.MakeMLPolicySourceWrapper().MPIPolicy(nil, nil, nil, nil).Obj()).
+					Obj()
+				return spec
+			}(),
+			trainJobs: []trainer.TrainJob{*activeTrainJob},
 			wantError: field.ErrorList{
-				field.Invalid(field.NewPath("spec").Child("template").Child("spec").Child("replicatedJobs").Index(0).Child("replicas"),
-					"2", ""),
-				field.Invalid(field.NewPath("spec").Child("template").Child("spec").Child("replicatedJobs").Index(1).Child("replicas"),
-					"2", ""),
-				field.Invalid(field.NewPath("spec").Child("template").Child("spec").Child("replicatedJobs").Index(2).Child("replicas"),
-					"2", ""),
-				field.Invalid(field.NewPath("spec").Child("template").Child("spec").Child("replicatedJobs").Index(3).Child("replicas"),
-					"2", ""),
+				field.Forbidden(field.NewPath("spec").Child("mlPolicy"), `must not change the mlPolicy kind from "torch" to "mpi"`),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotWarnings, gotErr := validateRuntimeUpdate(&oldSpec, tc.newSpec, tc.trainJobs)
+			if diff := cmp.Diff(tc.wantWarnings, gotWarnings); len(diff) != 0 {
+				t.Errorf("Unexpected warnings (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.IgnoreFields(field.Error{}, "Detail")); len(diff) != 0 {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRuntimeWebhooksValidateUpdate(t *testing.T) {
+	// The legacy runtimes were created before the launcher replicas validation was introduced.
+	legacyTrainingRuntime := testingutil.MakeTrainingRuntimeWrapper(metav1.NamespaceDefault, "runtime").Obj()
+	legacyTrainingRuntime.Spec.Template.Spec.ReplicatedJobs[0].Name = constants.Launcher
+	legacyTrainingRuntime.Spec.Template.Spec.ReplicatedJobs[0].Replicas = 2
+	legacyClTrainingRuntime := testingutil.MakeClusterTrainingRuntimeWrapper("runtime").Obj()
+	legacyClTrainingRuntime.Spec = *legacyTrainingRuntime.Spec.DeepCopy()
+	withFinalizer := func(obj client.Object) client.Object {
+		obj.SetFinalizers([]string{"example.com/finalizer"})
+		return obj
+	}
+	withNumNodes := func(spec *trainer.TrainingRuntimeSpec) {
+		spec.MLPolicy = testingutil.MakeMLPolicyWrapper().WithNumNodes(2).Obj()
+	}
+
+	cases := map[string]struct {
+		validator webhook.CustomValidator
+		oldObj    client.Object
+		newObj    func() client.Object
+		wantError bool
+	}{
+		"TrainingRuntime update without spec changes is allowed": {
+			validator: &TrainingRuntimeWebhook{},
+			oldObj:    legacyTrainingRuntime,
+			newObj: func() client.Object {
+				return withFinalizer(legacyTrainingRuntime.DeepCopy())
+			},
+		},
+		"TrainingRuntime update with spec changes is validated": {
+			validator: &TrainingRuntimeWebhook{},
+			oldObj:    legacyTrainingRuntime,
+			newObj: func() client.Object {
+				trainingRuntime := legacyTrainingRuntime.DeepCopy()
+				withNumNodes(&trainingRuntime.Spec)
+				return trainingRuntime
+			},
+			wantError: true,
+		},
+		"ClusterTrainingRuntime update without spec changes is allowed": {
+			validator: &ClusterTrainingRuntimeWebhook{},
+			oldObj:    legacyClTrainingRuntime,
+			newObj: func() client.Object {
+				return withFinalizer(legacyClTrainingRuntime.DeepCopy())
+			},
+		},
+		"ClusterTrainingRuntime update with spec changes is validated": {
+			validator: &ClusterTrainingRuntimeWebhook{},
+			oldObj:    legacyClTrainingRuntime,
+			newObj: func() client.Object {
+				clTrainingRuntime := legacyClTrainingRuntime.DeepCopy()
+				withNumNodes(&clTrainingRuntime.Spec)
+				return clTrainingRuntime
 			},
+			wantError: true,
 		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			gotErr := validateReplicatedJobs(tc.rJobs)
-			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); len(diff) != 0 {
-				t.Errorf("validateReplicateJobs() mismatch (-want,+got):\n%s", diff)
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			cli := testingutil.NewClientBuilder().
+				WithIndex(&trainer.TrainJob{}, idxer.TrainJobRuntimeRefKey, idxer.IndexTrainJobTrainingRuntime).
+				WithIndex(&trainer.TrainJob{}, idxer.TrainJobClusterRuntimeRefKey, idxer.IndexTrainJobClusterTrainingRuntime).
+				Build()
+			switch w := tc.validator.(type) {
+			case *TrainingRuntimeWebhook:
+				w.client = cli
+			case *ClusterTrainingRuntimeWebhook:
+				w.client = cli
+			}
+			_, gotErr := tc.validator.ValidateUpdate(ctx, tc.oldObj, tc.newObj())
+			if diff := cmp.Diff(tc.wantError, gotErr != nil); len(diff) != 0 {
+				t.Errorf("Unexpected error (-want,+got):\n%s\nerror: %v", diff, gotErr)
 			}
 		})
 	}
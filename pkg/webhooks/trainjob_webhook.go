@@ -18,29 +18,129 @@ package webhooks
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
 	"github.com/kubeflow/trainer/v2/pkg/runtime"
 )
 
 type TrainJobWebhook struct {
+	client   client.Client
 	runtimes map[string]runtime.Runtime
 }
 
 func setupWebhookForTrainJob(mgr ctrl.Manager, run map[string]runtime.Runtime) error {
+	wh := &TrainJobWebhook{client: mgr.GetClient(), runtimes: run}
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&trainer.TrainJob{}).
-		WithValidator(&TrainJobWebhook{runtimes: run}).
+		WithDefaulter(wh).
+		WithValidator(wh).
 		Complete()
 }
 
+// +kubebuilder:webhook:path=/mutate-trainer-kubeflow-org-v1alpha1-trainjob,mutating=true,failurePolicy=fail,sideEffects=None,groups=trainer.kubeflow.org,resources=trainjobs,verbs=create;update,versions=v1alpha1,name=defaulter.trainjob.trainer.kubeflow.org,admissionReviewVersions=v1
+
+var _ webhook.CustomDefaulter = (*TrainJobWebhook)(nil)
+
+func (w *TrainJobWebhook) Default(ctx context.Context, obj apiruntime.Object) error {
+	trainJob := obj.(*trainer.TrainJob)
+	log := ctrl.LoggerFrom(ctx).WithName("trainJob-webhook")
+	log.V(5).Info("Defaulting", "TrainJob", klog.KObj(trainJob))
+
+	// The Namespace defaults are applied only at creation so that the existing TrainJobs are not affected
+	// by the updates to the Namespace annotations.
+	if req, err := admission.RequestFromContext(ctx); err == nil && req.Operation == admissionv1.Create {
+		namespace := trainJob.Namespace
+		if len(namespace) == 0 {
+			namespace = req.Namespace
+		}
+		if err = w.applyNamespaceDefaults(ctx, namespace, trainJob); err != nil {
+			return err
+		}
+	}
+
+	// The CRD defaults are not applied when the runtimeRef is omitted in the request.
+	if trainJob.Spec.RuntimeRef.APIGroup == nil {
+		trainJob.Spec.RuntimeRef.APIGroup = ptr.To(trainer.GroupVersion.Group)
+	}
+	if trainJob.Spec.RuntimeRef.Kind == nil {
+		trainJob.Spec.RuntimeRef.Kind = ptr.To(trainer.ClusterTrainingRuntimeKind)
+	}
+	if trainJob.Spec.ManagedBy == nil {
+		trainJob.Spec.ManagedBy = ptr.To(trainer.TrainJobControllerName)
+	}
+
+	// The unsupported runtime is reported by the validating webhook.
+	if runtime, ok := w.runtimes[runtime.RuntimeRefToRuntimeRegistryKey(trainJob.Spec.RuntimeRef)]; ok {
+		return runtime.DefaultObjects(ctx, trainJob)
+	}
+	return nil
+}
+
+// applyNamespaceDefaults copies the default runtime, labels, and annotations from the Namespace annotations.
+// The invalid annotation values are ignored.
+func (w *TrainJobWebhook) applyNamespaceDefaults(ctx context.Context, namespace string, trainJob *trainer.TrainJob) error {
+	log := ctrl.LoggerFrom(ctx).WithName("trainJob-webhook")
+	var ns corev1.Namespace
+	if err := w.client.Get(ctx, client.ObjectKey{Name: namespace}, &ns); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if value, ok := ns.Annotations[constants.AnnotationDefaultRuntime]; ok && len(trainJob.Spec.RuntimeRef.Name) == 0 {
+		kind, name := trainer.ClusterTrainingRuntimeKind, value
+		if k, n, found := strings.Cut(value, "/"); found {
+			kind, name = k, n
+		}
+		if (kind == trainer.ClusterTrainingRuntimeKind || kind == trainer.TrainingRuntimeKind) && len(name) != 0 {
+			trainJob.Spec.RuntimeRef = trainer.RuntimeRef{
+				Name:     name,
+				APIGroup: ptr.To(trainer.GroupVersion.Group),
+				Kind:     ptr.To(kind),
+			}
+		} else {
+			log.V(2).Info("Ignoring the invalid default runtime of the Namespace", "annotation", constants.AnnotationDefaultRuntime, "value", value)
+		}
+	}
+
+	for annotation, target := range map[string]*map[string]string{
+		constants.AnnotationDefaultTrainJobLabels:      &trainJob.Labels,
+		constants.AnnotationDefaultTrainJobAnnotations: &trainJob.Annotations,
+	} {
+		value, ok := ns.Annotations[annotation]
+		if !ok {
+			continue
+		}
+		var defaults map[string]string
+		if err := json.Unmarshal([]byte(value), &defaults); err != nil {
+			log.V(2).Info("Ignoring the invalid defaults of the Namespace", "annotation", annotation, "value", value)
+			continue
+		}
+		for k, v := range defaults {
+			if _, exists := (*target)[k]; exists {
+				continue
+			}
+			if *target == nil {
+				*target = make(map[string]string, len(defaults))
+			}
+			(*target)[k] = v
+		}
+	}
+	return nil
+}
+
 // +kubebuilder:webhook:path=/validate-trainer-kubeflow-org-v1alpha1-trainjob,mutating=false,failurePolicy=fail,sideEffects=None,groups=trainer.kubeflow.org,resources=trainjobs,verbs=create;update,versions=v1alpha1,name=validator.trainjob.trainer.kubeflow.org,admissionReviewVersions=v1
 
 var _ webhook.CustomValidator = (*TrainJobWebhook)(nil)
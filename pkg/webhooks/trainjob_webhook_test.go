@@ -0,0 +1,197 @@
+/*
+Copyright 2025 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	trainer "github.com/kubeflow/trainer/v2/pkg/apis/trainer/v1alpha1"
+	"github.com/kubeflow/trainer/v2/pkg/constants"
+	"github.com/kubeflow/trainer/v2/pkg/runtime"
+	testingutil "github.com/kubeflow/trainer/v2/pkg/util/testing"
+)
+
+type fakeDefaultingRuntime struct {
+	runtime.Runtime
+}
+
+func (f *fakeDefaultingRuntime) DefaultObjects(_ context.Context, trainJob *trainer.TrainJob) error {
+	if trainJob.Spec.Suspend == nil {
+		trainJob.Spec.Suspend = ptr.To(true)
+	}
+	return nil
+}
+
+func TestTrainJobWebhookDefault(t *testing.T) {
+	clusterTrainingRuntimeGVK := trainer.SchemeGroupVersion.WithKind(trainer.ClusterTrainingRuntimeKind)
+	trainingRuntimeGVK := trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind)
+	cases := map[string]struct {
+		namespace *corev1.Namespace
+		operation admissionv1.Operation
+		runtimes  map[string]runtime.Runtime
+		trainJob  *trainer.TrainJob
+		want      *trainer.TrainJob
+	}{
+		"runtimeRef and managedBy are defaulted": {
+			operation: admissionv1.Create,
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimeRef(trainer.SchemeGroupVersion.WithKind(""), "torch").
+				Obj(),
+			want: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimeRef(clusterTrainingRuntimeGVK, "torch").
+				ManagedBy(trainer.TrainJobControllerName).
+				Obj(),
+		},
+		"specified runtimeRef and managedBy are kept": {
+			operation: admissionv1.Update,
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimeRef(trainingRuntimeGVK, "torch").
+				ManagedBy(trainer.MultiKueueControllerName).
+				Obj(),
+			want: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimeRef(trainingRuntimeGVK, "torch").
+				ManagedBy(trainer.MultiKueueControllerName).
+				Obj(),
+		},
+		"Namespace defaults are applied at creation": {
+			namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: metav1.NamespaceDefault,
+					Annotations: map[string]string{
+						constants.AnnotationDefaultRuntime:             "TrainingRuntime/torch",
+						constants.AnnotationDefaultTrainJobLabels:      `{"team":"ml","env":"dev"}`,
+						constants.AnnotationDefaultTrainJobAnnotations: `{"owner":"ml-team"}`,
+					},
+				},
+			},
+			operation: admissionv1.Create,
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Label("env", "prod").
+				Obj(),
+			want: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Label("env", "prod").
+				Label("team", "ml").
+				Annotation("owner", "ml-team").
+				RuntimeRef(trainingRuntimeGVK, "torch").
+				ManagedBy(trainer.TrainJobControllerName).
+				Obj(),
+		},
+		"Namespace default runtime doesn't override the specified runtimeRef": {
+			namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: metav1.NamespaceDefault,
+					Annotations: map[string]string{
+						constants.AnnotationDefaultRuntime: "deepspeed",
+					},
+				},
+			},
+			operation: admissionv1.Create,
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimeRef(clusterTrainingRuntimeGVK, "torch").
+				Obj(),
+			want: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimeRef(clusterTrainingRuntimeGVK, "torch").
+				ManagedBy(trainer.TrainJobControllerName).
+				Obj(),
+		},
+		"Namespace defaults are not applied at update": {
+			namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: metav1.NamespaceDefault,
+					Annotations: map[string]string{
+						constants.AnnotationDefaultTrainJobLabels: `{"team":"ml"}`,
+					},
+				},
+			},
+			operation: admissionv1.Update,
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimeRef(clusterTrainingRuntimeGVK, "torch").
+				ManagedBy(trainer.TrainJobControllerName).
+				Obj(),
+			want: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimeRef(clusterTrainingRuntimeGVK, "torch").
+				ManagedBy(trainer.TrainJobControllerName).
+				Obj(),
+		},
+		"invalid Namespace defaults are ignored": {
+			namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: metav1.NamespaceDefault,
+					Annotations: map[string]string{
+						constants.AnnotationDefaultRuntime:        "Invalid/torch",
+						constants.AnnotationDefaultTrainJobLabels: "team=ml",
+					},
+				},
+			},
+			operation: admissionv1.Create,
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				Obj(),
+			want: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimeRef(clusterTrainingRuntimeGVK, "").
+				ManagedBy(trainer.TrainJobControllerName).
+				Obj(),
+		},
+		"runtime plugins contribute defaults": {
+			operation: admissionv1.Create,
+			runtimes: map[string]runtime.Runtime{
+				runtime.RuntimeRefToRuntimeRegistryKey(trainer.RuntimeRef{
+					APIGroup: ptr.To(trainer.GroupVersion.Group),
+					Kind:     ptr.To(trainer.ClusterTrainingRuntimeKind),
+				}): &fakeDefaultingRuntime{},
+			},
+			trainJob: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimeRef(clusterTrainingRuntimeGVK, "torch").
+				Obj(),
+			want: testingutil.MakeTrainJobWrapper(metav1.NamespaceDefault, "test").
+				RuntimeRef(clusterTrainingRuntimeGVK, "torch").
+				ManagedBy(trainer.TrainJobControllerName).
+				Suspend(true).
+				Obj(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			clientBuilder := testingutil.NewClientBuilder()
+			if tc.namespace != nil {
+				clientBuilder = clientBuilder.WithObjects(tc.namespace)
+			}
+			w := &TrainJobWebhook{client: clientBuilder.Build(), runtimes: tc.runtimes}
+			ctx = admission.NewContextWithRequest(ctx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: tc.operation,
+					Namespace: tc.trainJob.Namespace,
+				},
+			})
+			if err := w.Default(ctx, tc.trainJob); err != nil {
+				t.Fatalf("Unexpected error from Default: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.trainJob); len(diff) != 0 {
+				t.Errorf("Unexpected TrainJob (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -186,6 +186,24 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 			})
 
+			ginkgo.It("Should not reconcile TrainJob managed by the MultiKueue", func() {
+				ginkgo.By("Creating TrainingRuntime and TrainJob with the MultiKueue managedBy")
+				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
+				gomega.Eventually(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(trainingRuntime), trainingRuntime)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+				trainJob.Spec.ManagedBy = ptr.To(trainer.MultiKueueControllerName)
+				gomega.Expect(k8sClient.Create(ctx, trainJob)).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the JobSet is not created and TrainJob status is not updated")
+				gomega.Consistently(func(g gomega.Gomega) {
+					g.Expect(k8sClient.Get(ctx, trainJobKey, &jobsetv1alpha2.JobSet{})).Should(testingutil.BeNotFoundError())
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(gotTrainJob.Status).Should(gomega.BeComparableTo(trainer.TrainJobStatus{}))
+				}, util.ConsistentDuration, util.Interval).Should(gomega.Succeed())
+			})
+
 			ginkgo.It("Should succeeded to update JobSet only when TrainJob is suspended", func() {
 				ginkgo.By("Creating TrainingRuntime and suspended TrainJob")
 				gomega.Expect(k8sClient.Create(ctx, trainingRuntime)).Should(gomega.Succeed())
@@ -417,12 +435,24 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 					gotTrainJob := &trainer.TrainJob{}
 					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
 					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.BeComparableTo([]metav1.Condition{
+						{
+							Type:    trainer.TrainJobCreated,
+							Status:  metav1.ConditionTrue,
+							Reason:  trainer.TrainJobResourcesCreatedReason,
+							Message: constants.TrainJobResourcesCreatedMessage,
+						},
 						{
 							Type:    trainer.TrainJobSuspended,
 							Status:  metav1.ConditionTrue,
 							Reason:  trainer.TrainJobSuspendedReason,
 							Message: constants.TrainJobSuspendedMessage,
 						},
+						{
+							Type:    trainer.TrainJobRunning,
+							Status:  metav1.ConditionFalse,
+							Reason:  trainer.TrainJobSuspendedReason,
+							Message: constants.TrainJobRunningSuspendedMessage,
+						},
 					}, util.IgnoreConditions))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 
@@ -434,15 +464,61 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 					g.Expect(k8sClient.Update(ctx, gotTrainJob)).Should(gomega.Succeed())
 					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
 					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.BeComparableTo([]metav1.Condition{
+						{
+							Type:    trainer.TrainJobCreated,
+							Status:  metav1.ConditionTrue,
+							Reason:  trainer.TrainJobResourcesCreatedReason,
+							Message: constants.TrainJobResourcesCreatedMessage,
+						},
 						{
 							Type:    trainer.TrainJobSuspended,
 							Status:  metav1.ConditionFalse,
 							Reason:  trainer.TrainJobResumedReason,
 							Message: constants.TrainJobResumedMessage,
 						},
+						{
+							Type:    trainer.TrainJobRunning,
+							Status:  metav1.ConditionFalse,
+							Reason:  trainer.TrainJobPodsPendingReason,
+							Message: constants.TrainJobPodsPendingMessage,
+						},
 					}, util.IgnoreConditions))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 
+				ginkgo.By("Updating the JobSet replicatedJobsStatus")
+				gomega.Eventually(func(g gomega.Gomega) {
+					jobSet := &jobsetv1alpha2.JobSet{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, jobSet)).Should(gomega.Succeed())
+					jobSet.Status.ReplicatedJobsStatus = []jobsetv1alpha2.ReplicatedJobStatus{
+						{
+							Name:   constants.Node,
+							Ready:  1,
+							Active: 1,
+						},
+					}
+					g.Expect(k8sClient.Status().Update(ctx, jobSet)).Should(gomega.Succeed())
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
+				ginkgo.By("Checking if the TrainJob has Running condition and jobsStatus propagated from the JobSet")
+				gomega.Eventually(func(g gomega.Gomega) {
+					gotTrainJob := &trainer.TrainJob{}
+					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
+					g.Expect(meta.FindStatusCondition(gotTrainJob.Status.Conditions, trainer.TrainJobRunning)).Should(gomega.BeComparableTo(&metav1.Condition{
+						Type:    trainer.TrainJobRunning,
+						Status:  metav1.ConditionTrue,
+						Reason:  trainer.TrainJobPodsRunningReason,
+						Message: constants.TrainJobPodsRunningMessage,
+					}, util.IgnoreConditions))
+					g.Expect(gotTrainJob.Status.StartTime).ShouldNot(gomega.BeNil())
+					g.Expect(gotTrainJob.Status.JobsStatus).Should(gomega.BeComparableTo([]trainer.JobStatus{
+						{
+							Name:   constants.Node,
+							Ready:  1,
+							Active: 1,
+						},
+					}))
+				}, util.Timeout, util.Interval).Should(gomega.Succeed())
+
 				ginkgo.By("Updating the JobSet condition with Completed")
 				gomega.Eventually(func(g gomega.Gomega) {
 					jobSet := &jobsetv1alpha2.JobSet{}
@@ -461,12 +537,24 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 					gotTrainJob := &trainer.TrainJob{}
 					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
 					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.BeComparableTo([]metav1.Condition{
+						{
+							Type:    trainer.TrainJobCreated,
+							Status:  metav1.ConditionTrue,
+							Reason:  trainer.TrainJobResourcesCreatedReason,
+							Message: constants.TrainJobResourcesCreatedMessage,
+						},
 						{
 							Type:    trainer.TrainJobSuspended,
 							Status:  metav1.ConditionFalse,
 							Reason:  trainer.TrainJobResumedReason,
 							Message: constants.TrainJobResumedMessage,
 						},
+						{
+							Type:    trainer.TrainJobRunning,
+							Status:  metav1.ConditionFalse,
+							Reason:  trainer.TrainJobFinishedReason,
+							Message: constants.TrainJobFinishedMessage,
+						},
 						{
 							Type:    trainer.TrainJobComplete,
 							Status:  metav1.ConditionTrue,
@@ -474,6 +562,7 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 							Message: jobsetconsts.AllJobsCompletedMessage,
 						},
 					}, util.IgnoreConditions))
+					g.Expect(gotTrainJob.Status.CompletionTime).ShouldNot(gomega.BeNil())
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 			})
 
@@ -504,12 +593,24 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 					gotTrainJob := &trainer.TrainJob{}
 					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
 					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.BeComparableTo([]metav1.Condition{
+						{
+							Type:    trainer.TrainJobCreated,
+							Status:  metav1.ConditionTrue,
+							Reason:  trainer.TrainJobResourcesCreatedReason,
+							Message: constants.TrainJobResourcesCreatedMessage,
+						},
 						{
 							Type:    trainer.TrainJobSuspended,
 							Status:  metav1.ConditionFalse,
 							Reason:  trainer.TrainJobResumedReason,
 							Message: constants.TrainJobResumedMessage,
 						},
+						{
+							Type:    trainer.TrainJobRunning,
+							Status:  metav1.ConditionFalse,
+							Reason:  trainer.TrainJobPodsPendingReason,
+							Message: constants.TrainJobPodsPendingMessage,
+						},
 					}, util.IgnoreConditions))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 
@@ -531,12 +632,24 @@ var _ = ginkgo.Describe("TrainJob controller", ginkgo.Ordered, func() {
 					gotTrainJob := &trainer.TrainJob{}
 					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
 					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.BeComparableTo([]metav1.Condition{
+						{
+							Type:    trainer.TrainJobCreated,
+							Status:  metav1.ConditionTrue,
+							Reason:  trainer.TrainJobResourcesCreatedReason,
+							Message: constants.TrainJobResourcesCreatedMessage,
+						},
 						{
 							Type:    trainer.TrainJobSuspended,
 							Status:  metav1.ConditionFalse,
 							Reason:  trainer.TrainJobResumedReason,
 							Message: constants.TrainJobResumedMessage,
 						},
+						{
+							Type:    trainer.TrainJobRunning,
+							Status:  metav1.ConditionFalse,
+							Reason:  trainer.TrainJobFinishedReason,
+							Message: constants.TrainJobFinishedMessage,
+						},
 						{
 							Type:    trainer.TrainJobFailed,
 							Status:  metav1.ConditionTrue,
@@ -894,12 +1007,24 @@ alpha-node-0-1.alpha slots=8
 					gotTrainJob := &trainer.TrainJob{}
 					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
 					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.BeComparableTo([]metav1.Condition{
+						{
+							Type:    trainer.TrainJobCreated,
+							Status:  metav1.ConditionTrue,
+							Reason:  trainer.TrainJobResourcesCreatedReason,
+							Message: constants.TrainJobResourcesCreatedMessage,
+						},
 						{
 							Type:    trainer.TrainJobSuspended,
 							Status:  metav1.ConditionTrue,
 							Reason:  trainer.TrainJobSuspendedReason,
 							Message: constants.TrainJobSuspendedMessage,
 						},
+						{
+							Type:    trainer.TrainJobRunning,
+							Status:  metav1.ConditionFalse,
+							Reason:  trainer.TrainJobSuspendedReason,
+							Message: constants.TrainJobRunningSuspendedMessage,
+						},
 					}, util.IgnoreConditions))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 
@@ -911,12 +1036,24 @@ alpha-node-0-1.alpha slots=8
 					g.Expect(k8sClient.Update(ctx, gotTrainJob)).Should(gomega.Succeed())
 					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
 					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.BeComparableTo([]metav1.Condition{
+						{
+							Type:    trainer.TrainJobCreated,
+							Status:  metav1.ConditionTrue,
+							Reason:  trainer.TrainJobResourcesCreatedReason,
+							Message: constants.TrainJobResourcesCreatedMessage,
+						},
 						{
 							Type:    trainer.TrainJobSuspended,
 							Status:  metav1.ConditionFalse,
 							Reason:  trainer.TrainJobResumedReason,
 							Message: constants.TrainJobResumedMessage,
 						},
+						{
+							Type:    trainer.TrainJobRunning,
+							Status:  metav1.ConditionFalse,
+							Reason:  trainer.TrainJobPodsPendingReason,
+							Message: constants.TrainJobPodsPendingMessage,
+						},
 					}, util.IgnoreConditions))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 
@@ -938,12 +1075,24 @@ alpha-node-0-1.alpha slots=8
 					gotTrainJob := &trainer.TrainJob{}
 					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
 					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.BeComparableTo([]metav1.Condition{
+						{
+							Type:    trainer.TrainJobCreated,
+							Status:  metav1.ConditionTrue,
+							Reason:  trainer.TrainJobResourcesCreatedReason,
+							Message: constants.TrainJobResourcesCreatedMessage,
+						},
 						{
 							Type:    trainer.TrainJobSuspended,
 							Status:  metav1.ConditionFalse,
 							Reason:  trainer.TrainJobResumedReason,
 							Message: constants.TrainJobResumedMessage,
 						},
+						{
+							Type:    trainer.TrainJobRunning,
+							Status:  metav1.ConditionFalse,
+							Reason:  trainer.TrainJobFinishedReason,
+							Message: constants.TrainJobFinishedMessage,
+						},
 						{
 							Type:    trainer.TrainJobComplete,
 							Status:  metav1.ConditionTrue,
@@ -982,12 +1131,24 @@ alpha-node-0-1.alpha slots=8
 					gotTrainJob := &trainer.TrainJob{}
 					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
 					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.BeComparableTo([]metav1.Condition{
+						{
+							Type:    trainer.TrainJobCreated,
+							Status:  metav1.ConditionTrue,
+							Reason:  trainer.TrainJobResourcesCreatedReason,
+							Message: constants.TrainJobResourcesCreatedMessage,
+						},
 						{
 							Type:    trainer.TrainJobSuspended,
 							Status:  metav1.ConditionFalse,
 							Reason:  trainer.TrainJobResumedReason,
 							Message: constants.TrainJobResumedMessage,
 						},
+						{
+							Type:    trainer.TrainJobRunning,
+							Status:  metav1.ConditionFalse,
+							Reason:  trainer.TrainJobPodsPendingReason,
+							Message: constants.TrainJobPodsPendingMessage,
+						},
 					}, util.IgnoreConditions))
 				}, util.Timeout, util.Interval).Should(gomega.Succeed())
 
@@ -1009,12 +1170,24 @@ alpha-node-0-1.alpha slots=8
 					gotTrainJob := &trainer.TrainJob{}
 					g.Expect(k8sClient.Get(ctx, trainJobKey, gotTrainJob)).Should(gomega.Succeed())
 					g.Expect(gotTrainJob.Status.Conditions).Should(gomega.BeComparableTo([]metav1.Condition{
+						{
+							Type:    trainer.TrainJobCreated,
+							Status:  metav1.ConditionTrue,
+							Reason:  trainer.TrainJobResourcesCreatedReason,
+							Message: constants.TrainJobResourcesCreatedMessage,
+						},
 						{
 							Type:    trainer.TrainJobSuspended,
 							Status:  metav1.ConditionFalse,
 							Reason:  trainer.TrainJobResumedReason,
 							Message: constants.TrainJobResumedMessage,
 						},
+						{
+							Type:    trainer.TrainJobRunning,
+							Status:  metav1.ConditionFalse,
+							Reason:  trainer.TrainJobFinishedReason,
+							Message: constants.TrainJobFinishedMessage,
+						},
 						{
 							Type:    trainer.TrainJobFailed,
 							Status:  metav1.ConditionTrue,
@@ -131,14 +131,34 @@ var _ = ginkgo.Describe("TrainingRuntime marker validations and defaulting", gin
 					return runtime
 				},
 				testingutil.BeInvalidError()),
-			ginkgo.Entry("Should fail to create trainingRuntime with minNodes and torch.elasticPolicy",
+			ginkgo.Entry("Should succeed to create trainingRuntime with numNodes within torch.elasticPolicy",
 				func() *trainer.TrainingRuntime {
 					runtime := testingutil.MakeTrainingRuntimeWrapper(ns.Name, "runtime").Obj()
 					runtime.Spec.MLPolicy = &trainer.MLPolicy{
 						NumNodes: ptr.To[int32](2),
 						MLPolicySource: trainer.MLPolicySource{
 							Torch: &trainer.TorchMLPolicySource{
-								ElasticPolicy: &trainer.TorchElasticPolicy{},
+								ElasticPolicy: &trainer.TorchElasticPolicy{
+									MinNodes: ptr.To[int32](1),
+									MaxNodes: ptr.To[int32](4),
+								},
+							},
+						},
+					}
+					return runtime
+				},
+				gomega.Succeed()),
+			ginkgo.Entry("Should fail to create trainingRuntime with numNodes outside torch.elasticPolicy",
+				func() *trainer.TrainingRuntime {
+					runtime := testingutil.MakeTrainingRuntimeWrapper(ns.Name, "runtime").Obj()
+					runtime.Spec.MLPolicy = &trainer.MLPolicy{
+						NumNodes: ptr.To[int32](2),
+						MLPolicySource: trainer.MLPolicySource{
+							Torch: &trainer.TorchMLPolicySource{
+								ElasticPolicy: &trainer.TorchElasticPolicy{
+									MinNodes: ptr.To[int32](3),
+									MaxNodes: ptr.To[int32](4),
+								},
 							},
 						},
 					}
@@ -163,6 +183,24 @@ var _ = ginkgo.Describe("TrainingRuntime marker validations and defaulting", gin
 				},
 				testingutil.BeInvalidError(),
 			),
+			ginkgo.Entry("Should fail to create trainingRuntime with mpi mlPolicy and without launcher replicatedJob",
+				func() *trainer.TrainingRuntime {
+					return testingutil.MakeTrainingRuntimeWrapper(ns.Name, "runtime").
+						RuntimeSpec(testingutil.MakeTrainingRuntimeSpecWrapper(
+							testingutil.MakeTrainingRuntimeWrapper(ns.Name, "runtime").Obj().Spec).
+							WithMLPolicy(
+								testingutil.MakeMLPolicyWrapper().
+									WithMLPolicySource(*testingutil.MakeMLPolicySourceWrapper().
+										MPIPolicy(ptr.To[int32](1), ptr.To(trainer.MPIImplementationOpenMPI), ptr.To("/usr/dir"), ptr.To(false)).
+										Obj(),
+									).
+									Obj(),
+							).
+							Obj()).
+						Obj()
+				},
+				testingutil.BeInvalidError(),
+			),
 		)
 		ginkgo.DescribeTable("Defaulting TrainingRuntime on creation", func(trainingRuntime func() *trainer.TrainingRuntime, wantTrainingRuntime func() *trainer.TrainingRuntime) {
 			created := trainingRuntime()
@@ -209,6 +247,7 @@ var _ = ginkgo.Describe("TrainingRuntime marker validations and defaulting", gin
 							).
 							JobSetSpec(
 								testingutil.MakeJobSetWrapper(ns.Name, "jobset").
+									LauncherReplica().
 									Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
 									Obj().
 									Spec,
@@ -231,6 +270,7 @@ var _ = ginkgo.Describe("TrainingRuntime marker validations and defaulting", gin
 							).
 							JobSetSpec(
 								testingutil.MakeJobSetWrapper(ns.Name, "jobset").
+									LauncherReplica().
 									Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
 									Obj().
 									Spec,
@@ -254,6 +294,7 @@ var _ = ginkgo.Describe("TrainingRuntime marker validations and defaulting", gin
 							).
 							JobSetSpec(
 								testingutil.MakeJobSetWrapper(ns.Name, "jobset").
+									LauncherReplica().
 									Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
 									Obj().
 									Spec,
@@ -276,6 +317,7 @@ var _ = ginkgo.Describe("TrainingRuntime marker validations and defaulting", gin
 							).
 							JobSetSpec(
 								testingutil.MakeJobSetWrapper(ns.Name, "jobset").
+									LauncherReplica().
 									Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
 									Obj().
 									Spec,
@@ -299,6 +341,7 @@ var _ = ginkgo.Describe("TrainingRuntime marker validations and defaulting", gin
 							).
 							JobSetSpec(
 								testingutil.MakeJobSetWrapper(ns.Name, "jobset").
+									LauncherReplica().
 									Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
 									Obj().
 									Spec,
@@ -321,6 +364,7 @@ var _ = ginkgo.Describe("TrainingRuntime marker validations and defaulting", gin
 							).
 							JobSetSpec(
 								testingutil.MakeJobSetWrapper(ns.Name, "jobset").
+									LauncherReplica().
 									Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
 									Obj().
 									Spec,
@@ -344,6 +388,7 @@ var _ = ginkgo.Describe("TrainingRuntime marker validations and defaulting", gin
 							).
 							JobSetSpec(
 								testingutil.MakeJobSetWrapper(ns.Name, "jobset").
+									LauncherReplica().
 									Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
 									Obj().
 									Spec,
@@ -366,6 +411,7 @@ var _ = ginkgo.Describe("TrainingRuntime marker validations and defaulting", gin
 							).
 							JobSetSpec(
 								testingutil.MakeJobSetWrapper(ns.Name, "jobset").
+									LauncherReplica().
 									Replicas(1, constants.Node, constants.DatasetInitializer, constants.ModelInitializer, constants.Launcher).
 									Obj().
 									Spec,
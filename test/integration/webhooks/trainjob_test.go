@@ -17,6 +17,8 @@ limitations under the License.
 package webhooks
 
 import (
+	"time"
+
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -217,6 +219,30 @@ var _ = ginkgo.Describe("TrainJob Webhook", ginkgo.Ordered, func() {
 						Obj()
 				},
 				testingutil.BeForbiddenError()),
+			ginkgo.Entry("Should succeed in creating trainJob referencing the deprecated trainingRuntime before the rejectAfter time",
+				func() *trainer.TrainJob {
+					trainingRuntime.Spec.Deprecation = &trainer.RuntimeDeprecation{
+						ReplacedBy:  &trainer.RuntimeRef{Name: "new-runtime", Kind: ptr.To(trainer.TrainingRuntimeKind)},
+						RejectAfter: ptr.To(metav1.NewTime(time.Now().Add(time.Hour))),
+					}
+					gomega.Expect(k8sClient.Update(ctx, trainingRuntime)).To(gomega.Succeed())
+					return testingutil.MakeTrainJobWrapper(ns.Name, jobName).
+						RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), runtimeName).
+						Obj()
+				},
+				gomega.Succeed()),
+			ginkgo.Entry("Should fail in creating trainJob referencing the deprecated trainingRuntime after the rejectAfter time",
+				func() *trainer.TrainJob {
+					trainingRuntime.Spec.Deprecation = &trainer.RuntimeDeprecation{
+						ReplacedBy:  &trainer.RuntimeRef{Name: "new-runtime", Kind: ptr.To(trainer.TrainingRuntimeKind)},
+						RejectAfter: ptr.To(metav1.NewTime(time.Now().Add(-time.Hour))),
+					}
+					gomega.Expect(k8sClient.Update(ctx, trainingRuntime)).To(gomega.Succeed())
+					return testingutil.MakeTrainJobWrapper(ns.Name, jobName).
+						RuntimeRef(trainer.GroupVersion.WithKind(trainer.TrainingRuntimeKind), runtimeName).
+						Obj()
+				},
+				testingutil.BeForbiddenError()),
 		)
 	})
 })
@@ -415,6 +441,20 @@ var _ = ginkgo.Describe("TrainJob marker validations and defaulting", ginkgo.Ord
 					return job
 				},
 				testingutil.BeInvalidError()),
+			ginkgo.Entry("Should fail to update failurePolicy",
+				func() *trainer.TrainJob {
+					return testingutil.MakeTrainJobWrapper(ns.Name, "valid-failure-policy").
+						RuntimeRef(trainer.SchemeGroupVersion.WithKind(trainer.TrainingRuntimeKind), "testing").
+						FailurePolicy(&trainer.FailurePolicy{
+							MaxRestarts: ptr.To[int32](1),
+						}).
+						Obj()
+				},
+				func(job *trainer.TrainJob) *trainer.TrainJob {
+					job.Spec.FailurePolicy.MaxRestarts = ptr.To[int32](3)
+					return job
+				},
+				testingutil.BeInvalidError()),
 		)
 	})
 })